@@ -0,0 +1,44 @@
+package i18n
+
+import "sync"
+
+type keyAliases struct {
+	mutex  sync.RWMutex
+	keys   map[string]string
+	warned map[string]bool
+}
+
+// AddKeyAlias make lookups for oldKey resolve newKey, it helps to rename keys gradually
+// A deprecation warning will be logged with the logger set by `SetLogger` when oldKey is used the first time
+func (i18n *I18n) AddKeyAlias(oldKey, newKey string) {
+	if i18n.aliases == nil {
+		i18n.aliases = &keyAliases{keys: map[string]string{}, warned: map[string]bool{}}
+	}
+
+	i18n.aliases.mutex.Lock()
+	i18n.aliases.keys[oldKey] = newKey
+	i18n.aliases.mutex.Unlock()
+}
+
+func (i18n *I18n) resolveKeyAlias(key string) string {
+	if i18n.aliases == nil {
+		return key
+	}
+
+	i18n.aliases.mutex.RLock()
+	newKey, ok := i18n.aliases.keys[key]
+	warned := i18n.aliases.warned[key]
+	i18n.aliases.mutex.RUnlock()
+
+	if !ok {
+		return key
+	}
+
+	if !warned && i18n.logger != nil {
+		i18n.aliases.mutex.Lock()
+		i18n.aliases.warned[key] = true
+		i18n.aliases.mutex.Unlock()
+		i18n.logger.Printf("Translation key %v is deprecated, please use %v instead", key, newKey)
+	}
+	return newKey
+}
@@ -0,0 +1,28 @@
+package i18n
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAddKeyAlias(t *testing.T) {
+	i18n := New(&backend{})
+	logger := &testLogger{}
+	i18n.SetLogger(logger)
+	i18n.AddTranslation(&Translation{Key: "home.welcome", Locale: "en-US", Value: "Welcome"})
+	i18n.AddTranslation(&Translation{Key: "home.greeting", Locale: "en-US", Value: "Old Greeting"})
+	i18n.AddKeyAlias("home.greeting", "home.welcome")
+
+	if result := i18n.T("en-US", "home.greeting"); result != "Welcome" {
+		t.Errorf("alias should resolve new key's value, but got %v", result)
+	}
+	i18n.T("en-US", "home.greeting")
+
+	if len(logger.messages) != 1 || !strings.Contains(logger.messages[0], "home.greeting is deprecated") {
+		t.Errorf("deprecation should be logged once, but got %v", logger.messages)
+	}
+
+	if result := i18n.Fallbacks("zh-CN").T("en-US", "home.greeting"); result != "Welcome" {
+		t.Errorf("alias should be shared with copies, but got %v", result)
+	}
+}
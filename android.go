@@ -0,0 +1,214 @@
+package i18n
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+type androidResources struct {
+	XMLName xml.Name        `xml:"resources"`
+	Strings []androidString `xml:"string"`
+	Plurals []androidPlural `xml:"plurals"`
+}
+
+// androidString string resource, Markup holds raw content of strings with child elements like `<xliff:g>` or `<b>`
+type androidString struct {
+	Name   string `xml:"name,attr"`
+	Value  string `xml:",chardata"`
+	Markup string `xml:",innerxml"`
+}
+
+type androidPlural struct {
+	Name  string              `xml:"name,attr"`
+	Items []androidPluralItem `xml:"item"`
+}
+
+type androidPluralItem struct {
+	Quantity string `xml:"quantity,attr"`
+	Value    string `xml:",chardata"`
+	Markup   string `xml:",innerxml"`
+}
+
+// ImportAndroidXML import translations of locale from Android strings.xml, `<string name="key">` will be imported as key
+// Items of `<plurals name="key">` will be imported as sub keys with CLDR plural categories like `key.one`, `key.other`, so they could be read with `PluralForms`
+// Child elements like `<xliff:g id="name">%1$s</xliff:g>` are kept as markup in imported values
+// Existing translations are kept by default, pass `ImportOptions` to choose another strategy
+func (i18n *I18n) ImportAndroidXML(locale string, r io.Reader, opts ...ImportOptions) error {
+	if locale == "" {
+		return errors.New("locale is required to import translations")
+	}
+
+	var resources androidResources
+	if err := xml.NewDecoder(r).Decode(&resources); err != nil {
+		return fmt.Errorf("failed to parse Android strings.xml, got: %v", err)
+	}
+
+	var translations []*Translation
+	for _, str := range resources.Strings {
+		translations = append(translations, &Translation{Key: str.Name, Locale: locale, Value: androidValue(str.Value, str.Markup)})
+	}
+
+	for _, plural := range resources.Plurals {
+		for _, item := range plural.Items {
+			if !isPluralCategory(item.Quantity) {
+				return fmt.Errorf("unknown quantity %v of plurals %v", item.Quantity, plural.Name)
+			}
+			translations = append(translations, &Translation{Key: plural.Name + "." + item.Quantity, Locale: locale, Value: androidValue(item.Value, item.Markup)})
+		}
+	}
+
+	return i18n.importTranslations(translations, importOptionsFrom(opts))
+}
+
+// androidValue return value of Android string resource, raw content is used if it has child elements, so markup isn't lost
+func androidValue(chardata, markup string) string {
+	if hasXMLElements(markup) {
+		return unescapeAndroidString(markup, true)
+	}
+	return unescapeAndroidString(chardata, false)
+}
+
+// hasXMLElements check if value is well-formed XML content with child elements
+func hasXMLElements(value string) bool {
+	if !strings.Contains(value, "<") {
+		return false
+	}
+
+	var (
+		decoder  = xml.NewDecoder(strings.NewReader("<root>" + value + "</root>"))
+		elements int
+	)
+	for {
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			return elements > 1
+		} else if err != nil {
+			return false
+		}
+		if _, ok := token.(xml.StartElement); ok {
+			elements++
+		}
+	}
+}
+
+// unescapeAndroidString unescape Android string resource, quoted parts keep their whitespaces, other whitespaces are collapsed
+// Tags are kept as they are if value is markup
+func unescapeAndroidString(value string, markup bool) string {
+	var (
+		result  []rune
+		quoted  bool
+		escaped bool
+		space   bool
+		tag     bool
+	)
+
+	for _, r := range strings.TrimSpace(value) {
+		switch {
+		case tag:
+			result, tag = append(result, r), r != '>'
+		case markup && !escaped && r == '<':
+			result, tag, space = append(result, r), true, false
+		case escaped:
+			escaped = false
+			switch r {
+			case 'n':
+				result = append(result, '\n')
+			case 't':
+				result = append(result, '\t')
+			default:
+				result = append(result, r)
+			}
+		case r == '\\':
+			escaped, space = true, false
+		case r == '"':
+			quoted, space = !quoted, false
+		case !quoted && (r == ' ' || r == '\n' || r == '\t' || r == '\r'):
+			if !space {
+				result = append(result, ' ')
+			}
+			space = true
+		default:
+			result, space = append(result, r), false
+		}
+	}
+	return string(result)
+}
+
+// ExportAndroidXML export translations of locale as Android strings.xml
+// Keys end with CLDR plural categories like `key.one`, `key.other` will be exported as `<plurals name="key">`
+func (i18n *I18n) ExportAndroidXML(locale string, w io.Writer) error {
+	var (
+		resources          androidResources
+		singulars, plurals = groupPluralTranslations(i18n.LoadTranslations()[locale])
+	)
+
+	for _, translation := range singulars {
+		str := androidString{Name: translation.Key}
+		str.Value, str.Markup = androidContent(translation.Value)
+		resources.Strings = append(resources.Strings, str)
+	}
+
+	for _, plural := range plurals {
+		item := androidPlural{Name: plural.Key}
+		for _, form := range plural.Forms {
+			pluralItem := androidPluralItem{Quantity: form.Category}
+			pluralItem.Value, pluralItem.Markup = androidContent(form.Value)
+			item.Items = append(item.Items, pluralItem)
+		}
+		resources.Plurals = append(resources.Plurals, item)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "    ")
+	if err := encoder.Encode(resources); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// androidContent return content of value for Android string resource, values with child elements are written as raw markup
+func androidContent(value string) (chardata string, markup string) {
+	if hasXMLElements(value) {
+		return "", escapeAndroidString(value, true)
+	}
+	return escapeAndroidString(value, false), ""
+}
+
+var androidTagRegexp = regexp.MustCompile(`<[^>]*>`)
+
+// escapeAndroidString escape value for Android string resource, values with leading, trailing or consecutive spaces are quoted to keep them
+// Only text outside tags is escaped if value is markup
+func escapeAndroidString(value string, markup bool) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `'`, `\'`, `"`, `\"`, "\n", `\n`, "\t", `\t`)
+	escaped := replacer.Replace(value)
+	if markup {
+		var (
+			result strings.Builder
+			last   int
+		)
+		for _, loc := range androidTagRegexp.FindAllStringIndex(value, -1) {
+			result.WriteString(replacer.Replace(value[last:loc[0]]))
+			result.WriteString(value[loc[0]:loc[1]])
+			last = loc[1]
+		}
+		result.WriteString(replacer.Replace(value[last:]))
+		escaped = result.String()
+	}
+	if strings.HasPrefix(escaped, "@") || strings.HasPrefix(escaped, "?") {
+		escaped = `\` + escaped
+	}
+
+	if strings.TrimSpace(value) != value || strings.Contains(value, "  ") {
+		return `"` + escaped + `"`
+	}
+	return escaped
+}
@@ -0,0 +1,127 @@
+package i18n
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestImportAndroidXML(t *testing.T) {
+	i18n := New(&backend{})
+
+	content := `<?xml version="1.0" encoding="utf-8"?>
+<resources>
+    <string name="app_name">My App</string>
+    <string name="welcome">Don\'t forget to say \"hello\"\nto %1$s</string>
+    <string name="spaces">"  keep   spaces  "</string>
+    <string name="markup">Tom &amp; Jerry</string>
+    <plurals name="items">
+        <item quantity="one">%d item</item>
+        <item quantity="other">%d items</item>
+    </plurals>
+</resources>`
+
+	if err := i18n.ImportAndroidXML("en-US", strings.NewReader(content)); err != nil {
+		t.Fatalf("failed to import strings.xml, got %v", err)
+	}
+
+	for key, value := range map[string]string{
+		"app_name":    "My App",
+		"welcome":     "Don't forget to say \"hello\"\nto %1$s",
+		"spaces":      "  keep   spaces  ",
+		"markup":      "Tom & Jerry",
+		"items.one":   "%d item",
+		"items.other": "%d items",
+	} {
+		if result, _ := i18n.Raw("en-US", key); result != value {
+			t.Errorf("%v should be imported as %q, but got %q", key, value, result)
+		}
+	}
+
+	if forms := i18n.PluralForms("en-US", "items"); !reflect.DeepEqual(forms, map[string]string{"one": "%d item", "other": "%d items"}) {
+		t.Errorf("plurals should be imported as plural forms, but got %v", forms)
+	}
+
+	if err := i18n.ImportAndroidXML("en-US", strings.NewReader(`<resources><plurals name="x"><item quantity="several">x</item></plurals></resources>`)); err == nil {
+		t.Errorf("should return error for unknown quantity")
+	}
+}
+
+func TestImportAndroidXMLWithMarkup(t *testing.T) {
+	i18n := New(&sliceBackend{})
+
+	content := `<resources>
+    <string name="hello">Hello <xliff:g id="name" example="Bob">%1$s</xliff:g>!</string>
+    <string name="bold">Don\'t <b>touch</b> &amp; go</string>
+    <plurals name="files">
+        <item quantity="other"><xliff:g id="count">%d</xliff:g> files</item>
+    </plurals>
+</resources>`
+
+	if err := i18n.ImportAndroidXML("en-US", strings.NewReader(content)); err != nil {
+		t.Fatalf("failed to import strings.xml, got %v", err)
+	}
+
+	for key, value := range map[string]string{
+		"hello":       `Hello <xliff:g id="name" example="Bob">%1$s</xliff:g>!`,
+		"bold":        `Don't <b>touch</b> &amp; go`,
+		"files.other": `<xliff:g id="count">%d</xliff:g> files`,
+	} {
+		if result, _ := i18n.Raw("en-US", key); result != value {
+			t.Errorf("%v should be imported as %q, but got %q", key, value, result)
+		}
+	}
+
+	var buf strings.Builder
+	if err := i18n.ExportAndroidXML("en-US", &buf); err != nil {
+		t.Fatalf("failed to export strings.xml, got %v", err)
+	}
+	for _, str := range []string{`<string name="hello">Hello <xliff:g id="name" example="Bob">%1$s</xliff:g>!</string>`, `<string name="bold">Don\'t <b>touch</b> &amp; go</string>`} {
+		if !strings.Contains(buf.String(), str) {
+			t.Errorf("markup should be exported as it is, expected %v, but got %v", str, buf.String())
+		}
+	}
+}
+
+func TestExportAndroidXML(t *testing.T) {
+	i18n := New(&sliceBackend{translations: []*Translation{
+		{Key: "app_name", Locale: "en-US", Value: "Tom & Jerry's <App>"},
+		{Key: "welcome", Locale: "en-US", Value: "Say \"hello\"\nto %1$s"},
+		{Key: "spaces", Locale: "en-US", Value: " keep  spaces "},
+		{Key: "reference", Locale: "en-US", Value: "@string/app_name"},
+		{Key: "items.one", Locale: "en-US", Value: "%d item"},
+		{Key: "items.other", Locale: "en-US", Value: "%d items"},
+		{Key: "app_name", Locale: "zh-CN", Value: "应用"},
+	}})
+
+	var buf strings.Builder
+	if err := i18n.ExportAndroidXML("en-US", &buf); err != nil {
+		t.Fatalf("failed to export strings.xml, got %v", err)
+	}
+
+	output := buf.String()
+	for _, str := range []string{`<string name="app_name">Tom &amp; Jerry\&#39;s &lt;App&gt;</string>`, `<plurals name="items">`, `<item quantity="one">%d item</item>`} {
+		if !strings.Contains(output, str) {
+			t.Errorf("exported strings.xml should contain %v, but got %v", str, output)
+		}
+	}
+
+	if strings.Contains(output, "应用") {
+		t.Errorf("translations of other locales shouldn't be exported")
+	}
+
+	imported := New(&backend{})
+	if err := imported.ImportAndroidXML("en-US", strings.NewReader(output)); err != nil {
+		t.Fatalf("failed to import exported strings.xml, got %v", err)
+	}
+
+	for _, translation := range i18n.LoadTranslationsSorted() {
+		if translation.Locale != "en-US" {
+			continue
+		}
+
+		if result, _ := imported.Raw("en-US", translation.Key); result != translation.Value {
+			t.Errorf("%v should be round-tripped as %q, but got %q", translation.Key, translation.Value, result)
+		}
+	}
+}
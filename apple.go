@@ -0,0 +1,350 @@
+package i18n
+
+import (
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ImportAppleStrings import translations of locale from Apple .strings file with `"key" = "value";` entries, comments are ignored
+// Existing translations are kept by default, pass `ImportOptions` to choose another strategy
+func (i18n *I18n) ImportAppleStrings(locale string, r io.Reader, opts ...ImportOptions) error {
+	if locale == "" {
+		return errors.New("locale is required to import translations")
+	}
+
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	values, err := parseAppleStrings(strings.TrimPrefix(string(content), "\ufeff"))
+	if err != nil {
+		return fmt.Errorf("failed to parse Apple strings, got: %v", err)
+	}
+
+	var translations []*Translation
+	for _, key := range sortedKeys(values) {
+		translations = append(translations, &Translation{Key: key, Locale: locale, Value: values[key]})
+	}
+	return i18n.importTranslations(translations, importOptionsFrom(opts))
+}
+
+// ExportAppleStrings export translations of locale as Apple .strings file, plural forms like `key.one`, `key.other` are exported with `ExportAppleStringsdict`
+func (i18n *I18n) ExportAppleStrings(locale string, w io.Writer) error {
+	singulars, _ := groupPluralTranslations(i18n.LoadTranslations()[locale])
+
+	writer := bufio.NewWriter(w)
+	for _, translation := range singulars {
+		fmt.Fprintf(writer, "%v = %v;\n", quoteAppleString(translation.Key), quoteAppleString(translation.Value))
+	}
+	return writer.Flush()
+}
+
+// ImportAppleStringsdict import plural translations of locale from Apple .stringsdict file, plural variants are imported as sub keys with CLDR plural categories like `key.one`, `key.other`
+// If the format of a key has multiple variables, e.g: `%#@files@ in %#@folders@`, the format is imported as key and variants as `key.files.one`, `key.folders.other`
+// Existing translations are kept by default, pass `ImportOptions` to choose another strategy
+func (i18n *I18n) ImportAppleStringsdict(locale string, r io.Reader, opts ...ImportOptions) error {
+	if locale == "" {
+		return errors.New("locale is required to import translations")
+	}
+
+	root, err := parsePlist(xml.NewDecoder(r))
+	if err != nil {
+		return fmt.Errorf("failed to parse Apple stringsdict, got: %v", err)
+	}
+
+	entries, ok := root.(map[string]interface{})
+	if !ok {
+		return errors.New("failed to parse Apple stringsdict, root should be a dict")
+	}
+
+	var translations []*Translation
+	for _, key := range sortedPlistKeys(entries) {
+		entry, _ := entries[key].(map[string]interface{})
+		format, _ := entry["NSStringLocalizedFormatKey"].(string)
+		variables := appleFormatVariableRegexp.FindAllStringSubmatch(format, -1)
+
+		prefix := key
+		if len(variables) != 1 || variables[0][0] != format {
+			translations = append(translations, &Translation{Key: key, Locale: locale, Value: format})
+		}
+
+		for _, variable := range variables {
+			rule, _ := entry[variable[1]].(map[string]interface{})
+			if rule["NSStringFormatSpecTypeKey"] != "NSStringPluralRuleType" {
+				continue
+			}
+
+			if len(variables) != 1 || variables[0][0] != format {
+				prefix = key + "." + variable[1]
+			}
+
+			for _, category := range PluralCategories {
+				if value, ok := rule[category].(string); ok {
+					translations = append(translations, &Translation{Key: prefix + "." + category, Locale: locale, Value: value})
+				}
+			}
+		}
+	}
+	return i18n.importTranslations(translations, importOptionsFrom(opts))
+}
+
+// ExportAppleStringsdict export plural translations of locale like `key.one`, `key.other` as Apple .stringsdict file
+func (i18n *I18n) ExportAppleStringsdict(locale string, w io.Writer) error {
+	_, plurals := groupPluralTranslations(i18n.LoadTranslations()[locale])
+
+	writer := bufio.NewWriter(w)
+	writer.WriteString(xml.Header)
+	writer.WriteString(`<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">` + "\n")
+	writer.WriteString("<plist version=\"1.0\">\n<dict>\n")
+	for _, plural := range plurals {
+		valueType := "d"
+		for _, form := range plural.Forms {
+			if matches := appleFormatSpecifierRegexp.FindStringSubmatch(form.Value); matches != nil {
+				valueType = matches[1]
+				break
+			}
+		}
+
+		fmt.Fprintf(writer, "    <key>%v</key>\n    <dict>\n", escapeXML(plural.Key))
+		writer.WriteString("        <key>NSStringLocalizedFormatKey</key>\n        <string>%#@value@</string>\n")
+		writer.WriteString("        <key>value</key>\n        <dict>\n")
+		writer.WriteString("            <key>NSStringFormatSpecTypeKey</key>\n            <string>NSStringPluralRuleType</string>\n")
+		fmt.Fprintf(writer, "            <key>NSStringFormatValueTypeKey</key>\n            <string>%v</string>\n", escapeXML(valueType))
+		for _, form := range plural.Forms {
+			fmt.Fprintf(writer, "            <key>%v</key>\n            <string>%v</string>\n", form.Category, escapeXML(form.Value))
+		}
+		writer.WriteString("        </dict>\n    </dict>\n")
+	}
+	writer.WriteString("</dict>\n</plist>\n")
+	return writer.Flush()
+}
+
+var (
+	appleFormatVariableRegexp  = regexp.MustCompile(`%#@(\w+)@`)
+	appleFormatSpecifierRegexp = regexp.MustCompile(`%(?:\d+\$)?(l{0,2}[dDuUxXoOfeEgGcCsSp@])`)
+)
+
+// parseAppleStrings parse `"key" = "value";` entries, keys and values could also be unquoted words
+func parseAppleStrings(content string) (map[string]string, error) {
+	var (
+		values = map[string]string{}
+		pos    int
+	)
+
+	skip := func() {
+		for pos < len(content) {
+			switch {
+			case strings.HasPrefix(content[pos:], "/*"):
+				if end := strings.Index(content[pos+2:], "*/"); end >= 0 {
+					pos += end + 4
+				} else {
+					pos = len(content)
+				}
+			case strings.HasPrefix(content[pos:], "//"):
+				if end := strings.Index(content[pos:], "\n"); end >= 0 {
+					pos += end + 1
+				} else {
+					pos = len(content)
+				}
+			case strings.ContainsRune(" \t\r\n", rune(content[pos])):
+				pos++
+			default:
+				return
+			}
+		}
+	}
+
+	token := func() (string, error) {
+		skip()
+		if pos >= len(content) {
+			return "", io.ErrUnexpectedEOF
+		}
+
+		if content[pos] != '"' {
+			start := pos
+			for pos < len(content) && !strings.ContainsRune(" \t\r\n=;\"", rune(content[pos])) {
+				pos++
+			}
+			if start == pos {
+				return "", fmt.Errorf("unexpected %q at %v", content[pos], pos)
+			}
+			return content[start:pos], nil
+		}
+
+		var value []rune
+		for pos++; pos < len(content); {
+			r, size := utf8.DecodeRuneInString(content[pos:])
+			pos += size
+			switch r {
+			case '"':
+				return string(value), nil
+			case '\\':
+				if pos >= len(content) {
+					return "", io.ErrUnexpectedEOF
+				}
+				switch content[pos] {
+				case 'n':
+					value = append(value, '\n')
+				case 't':
+					value = append(value, '\t')
+				case 'r':
+					value = append(value, '\r')
+				case 'U', 'u':
+					if pos+5 > len(content) {
+						return "", io.ErrUnexpectedEOF
+					}
+					code, err := strconv.ParseUint(content[pos+1:pos+5], 16, 32)
+					if err != nil {
+						return "", err
+					}
+					value = append(value, rune(code))
+					pos += 4
+				default:
+					value = append(value, rune(content[pos]))
+				}
+				pos++
+			default:
+				value = append(value, r)
+			}
+		}
+		return "", io.ErrUnexpectedEOF
+	}
+
+	expect := func(char byte) error {
+		skip()
+		if pos >= len(content) || content[pos] != char {
+			return fmt.Errorf("expected %q at %v", char, pos)
+		}
+		pos++
+		return nil
+	}
+
+	for skip(); pos < len(content); skip() {
+		key, err := token()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := expect('='); err != nil {
+			return nil, err
+		}
+
+		value, err := token()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := expect(';'); err != nil {
+			return nil, err
+		}
+		values[key] = value
+	}
+	return values, nil
+}
+
+// quoteAppleString quote value for Apple .strings file
+func quoteAppleString(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`, "\r", `\r`).Replace(value) + `"`
+}
+
+func escapeXML(value string) string {
+	var buf strings.Builder
+	xml.EscapeText(&buf, []byte(value))
+	return buf.String()
+}
+
+// parsePlist parse the first value of a property list, dicts are parsed as `map[string]interface{}`, arrays as `[]interface{}`, other values as strings
+func parsePlist(decoder *xml.Decoder) (interface{}, error) {
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		if start, ok := token.(xml.StartElement); ok && start.Name.Local != "plist" {
+			return parsePlistValue(decoder, start)
+		}
+	}
+}
+
+func parsePlistValue(decoder *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	switch start.Name.Local {
+	case "dict":
+		var (
+			dict = map[string]interface{}{}
+			key  string
+		)
+
+		for {
+			token, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			switch t := token.(type) {
+			case xml.StartElement:
+				if t.Name.Local == "key" {
+					if err := decoder.DecodeElement(&key, &t); err != nil {
+						return nil, err
+					}
+					continue
+				}
+
+				value, err := parsePlistValue(decoder, t)
+				if err != nil {
+					return nil, err
+				}
+				dict[key] = value
+			case xml.EndElement:
+				return dict, nil
+			}
+		}
+	case "array":
+		var array []interface{}
+		for {
+			token, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			switch t := token.(type) {
+			case xml.StartElement:
+				value, err := parsePlistValue(decoder, t)
+				if err != nil {
+					return nil, err
+				}
+				array = append(array, value)
+			case xml.EndElement:
+				return array, nil
+			}
+		}
+	case "true", "false":
+		if err := decoder.Skip(); err != nil {
+			return nil, err
+		}
+		return start.Name.Local, nil
+	}
+
+	var value string
+	err := decoder.DecodeElement(&value, &start)
+	return value, err
+}
+
+func sortedPlistKeys(dict map[string]interface{}) []string {
+	var keys []string
+	for key := range dict {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,150 @@
+package i18n
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAppleStrings(t *testing.T) {
+	i18n := New(&backend{})
+
+	content := "\ufeff/* Title of the app */\n" +
+		`"app_name" = "My App";
+// greeting
+"welcome" = "Say \"hello\"\nto %@";
+"unicode" = "caf\U00E9";
+title = "Title";
+`
+	if err := i18n.ImportAppleStrings("en-US", strings.NewReader(content)); err != nil {
+		t.Fatalf("failed to import strings, got %v", err)
+	}
+
+	expected := map[string]string{"app_name": "My App", "welcome": "Say \"hello\"\nto %@", "unicode": "café", "title": "Title"}
+	for key, value := range expected {
+		if result, _ := i18n.Raw("en-US", key); result != value {
+			t.Errorf("%v should be imported as %q, but got %q", key, value, result)
+		}
+	}
+
+	if err := i18n.ImportAppleStrings("en-US", strings.NewReader(`"missing" = "semicolon"`)); err == nil {
+		t.Errorf("should return error for invalid strings")
+	}
+
+	exporter := New(&sliceBackend{translations: []*Translation{
+		{Key: "app_name", Locale: "en-US", Value: "My App"},
+		{Key: "welcome", Locale: "en-US", Value: "Say \"hello\"\nto %@"},
+		{Key: "items.one", Locale: "en-US", Value: "%d item"},
+	}})
+
+	var buf strings.Builder
+	if err := exporter.ExportAppleStrings("en-US", &buf); err != nil {
+		t.Fatalf("failed to export strings, got %v", err)
+	}
+
+	if output := buf.String(); output != "\"app_name\" = \"My App\";\n\"welcome\" = \"Say \\\"hello\\\"\\nto %@\";\n" {
+		t.Errorf("exported strings is not correct, got %v", output)
+	}
+
+	imported := New(&backend{})
+	imported.ImportAppleStrings("en-US", strings.NewReader(buf.String()))
+	if result, _ := imported.Raw("en-US", "welcome"); result != "Say \"hello\"\nto %@" {
+		t.Errorf("exported strings should be imported back, but got %q", result)
+	}
+}
+
+func TestAppleStringsdict(t *testing.T) {
+	i18n := New(&backend{})
+
+	content := `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+    <key>items</key>
+    <dict>
+        <key>NSStringLocalizedFormatKey</key>
+        <string>%#@value@</string>
+        <key>value</key>
+        <dict>
+            <key>NSStringFormatSpecTypeKey</key>
+            <string>NSStringPluralRuleType</string>
+            <key>NSStringFormatValueTypeKey</key>
+            <string>d</string>
+            <key>one</key>
+            <string>%d item</string>
+            <key>other</key>
+            <string>%d items</string>
+        </dict>
+    </dict>
+    <key>summary</key>
+    <dict>
+        <key>NSStringLocalizedFormatKey</key>
+        <string>%#@files@ in %#@folders@</string>
+        <key>files</key>
+        <dict>
+            <key>NSStringFormatSpecTypeKey</key>
+            <string>NSStringPluralRuleType</string>
+            <key>one</key>
+            <string>%d file</string>
+            <key>other</key>
+            <string>%d files</string>
+        </dict>
+        <key>folders</key>
+        <dict>
+            <key>NSStringFormatSpecTypeKey</key>
+            <string>NSStringPluralRuleType</string>
+            <key>other</key>
+            <string>%d folders &amp; more</string>
+        </dict>
+    </dict>
+</dict>
+</plist>`
+
+	if err := i18n.ImportAppleStringsdict("en-US", strings.NewReader(content)); err != nil {
+		t.Fatalf("failed to import stringsdict, got %v", err)
+	}
+
+	for key, value := range map[string]string{
+		"items.one":             "%d item",
+		"items.other":           "%d items",
+		"summary":               "%#@files@ in %#@folders@",
+		"summary.files.one":     "%d file",
+		"summary.files.other":   "%d files",
+		"summary.folders.other": "%d folders & more",
+	} {
+		if result, _ := i18n.Raw("en-US", key); result != value {
+			t.Errorf("%v should be imported as %q, but got %q", key, value, result)
+		}
+	}
+
+	exporter := New(&sliceBackend{translations: []*Translation{
+		{Key: "app_name", Locale: "en-US", Value: "My App"},
+		{Key: "files.one", Locale: "en-US", Value: "%ld file & folder"},
+		{Key: "files.other", Locale: "en-US", Value: "%ld files & folders"},
+	}})
+
+	var buf strings.Builder
+	if err := exporter.ExportAppleStringsdict("en-US", &buf); err != nil {
+		t.Fatalf("failed to export stringsdict, got %v", err)
+	}
+
+	output := buf.String()
+	for _, str := range []string{"<string>ld</string>", "<string>%ld file &amp; folder</string>"} {
+		if !strings.Contains(output, str) {
+			t.Errorf("exported stringsdict should contain %v, but got %v", str, output)
+		}
+	}
+
+	if strings.Contains(output, "app_name") {
+		t.Errorf("singular translations shouldn't be exported to stringsdict")
+	}
+
+	imported := New(&backend{})
+	if err := imported.ImportAppleStringsdict("en-US", strings.NewReader(output)); err != nil {
+		t.Fatalf("failed to import exported stringsdict, got %v", err)
+	}
+
+	if forms := imported.PluralForms("en-US", "files"); !reflect.DeepEqual(forms, map[string]string{"one": "%ld file & folder", "other": "%ld files & folders"}) {
+		t.Errorf("exported stringsdict should be imported back, but got %v", forms)
+	}
+}
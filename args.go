@@ -0,0 +1,163 @@
+package i18n
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	templateActionRegexp = regexp.MustCompile(`{{(.*?)}}`)
+	positionalArgRegexp  = regexp.MustCompile(`\$(\d+)`)
+	pluralArgRegexp      = regexp.MustCompile(`\bp\s+(?:\$(\d+)|"(\w+)"|\.(\w+))`)
+	sprintfVerbRegexp    = regexp.MustCompile(`%%|%(?:\[\d+\])?[-+#0]*\d*(?:\.\d+)?[a-zA-Z]`)
+)
+
+// SetMaxPlaceholders set max count of placeholders a translation could have, translations have more placeholders will be returned without formatting
+// It guards against translations from untrusted editors, 0 means no limit
+func (i18n *I18n) SetMaxPlaceholders(max int) {
+	i18n.maxPlaceholders = max
+}
+
+var (
+	sprintfArgIndexRegexp = regexp.MustCompile(`^%\[(\d+)\]`)
+	fieldArgRegexp        = regexp.MustCompile(`(?:^|[\s(])\.(\w+)`)
+)
+
+// replaceUnfilledPlaceholders replace placeholders of pattern value that reference missing arguments with token before formatting, so arguments rendered into the result won't be touched
+// Template actions are checked for CLDR patterns and verbs are checked for Sprintf formatter
+func replaceUnfilledPlaceholders(value string, args []interface{}, isCLDR bool, token string) string {
+	if isCLDR {
+		return templateActionRegexp.ReplaceAllStringFunc(value, func(action string) string {
+			if referencesMissingArgs(templateActionRegexp.FindStringSubmatch(action)[1], args) {
+				return "{{" + strconv.Quote(token) + "}}"
+			}
+			return action
+		})
+	}
+
+	// values without arguments are returned as they are by Sprintf formatter, so token is only escaped when it will be formatted
+	if len(args) > 0 {
+		token = strings.Replace(token, "%", "%%", -1)
+	}
+	return replaceSprintfVerbs(value, func(verb string, argNum int) string {
+		if argNum > len(args) {
+			return token
+		}
+		return verb
+	})
+}
+
+// referencesMissingArgs check if template action references positional arguments or fields of data that aren't given
+func referencesMissingArgs(action string, args []interface{}) bool {
+	for _, matches := range positionalArgRegexp.FindAllStringSubmatch(action, -1) {
+		if idx, _ := strconv.Atoi(matches[1]); idx > len(args) {
+			return true
+		}
+	}
+
+	for _, matches := range fieldArgRegexp.FindAllStringSubmatch(action, -1) {
+		if len(args) == 0 {
+			return true
+		}
+		if _, ok := fieldValue(args[0], matches[1]); !ok {
+			return true
+		}
+	}
+	return false
+}
+
+// replaceSprintfVerbs replace `fmt` verbs of value with results of fn, which is called with the verb and number of the argument it references, `%%` is kept
+func replaceSprintfVerbs(value string, fn func(verb string, argNum int) string) string {
+	var argNum int
+	return sprintfVerbRegexp.ReplaceAllStringFunc(value, func(verb string) string {
+		if verb == "%%" {
+			return verb
+		}
+
+		if matches := sprintfArgIndexRegexp.FindStringSubmatch(verb); matches != nil {
+			argNum, _ = strconv.Atoi(matches[1])
+		} else {
+			argNum++
+		}
+		return fn(verb, argNum)
+	})
+}
+
+// countPlaceholders count template actions and `fmt` verbs of value
+func countPlaceholders(value string) (count int) {
+	count = len(templateActionRegexp.FindAllString(value, -1))
+	for _, verb := range sprintfVerbRegexp.FindAllString(value, -1) {
+		if verb != "%%" {
+			count++
+		}
+	}
+	return count
+}
+
+// validateArgs check arguments match placeholders of value, counts used by the plural function `p` need to be numbers
+func validateArgs(value string, args []interface{}) (errs []error) {
+	var actions string
+	for _, matches := range templateActionRegexp.FindAllStringSubmatch(value, -1) {
+		actions += matches[1] + "\n"
+	}
+
+	for _, matches := range positionalArgRegexp.FindAllStringSubmatch(actions, -1) {
+		if idx, _ := strconv.Atoi(matches[1]); idx > len(args) || idx < 1 {
+			errs = append(errs, fmt.Errorf("placeholder $%v has no argument, %v arguments given", idx, len(args)))
+		}
+	}
+
+	for _, matches := range pluralArgRegexp.FindAllStringSubmatch(actions, -1) {
+		var (
+			arg   interface{}
+			found bool
+			name  = matches[2] + matches[3]
+		)
+
+		if matches[1] != "" {
+			if idx, _ := strconv.Atoi(matches[1]); idx >= 1 && idx <= len(args) {
+				arg, found, name = args[idx-1], true, "$"+matches[1]
+			}
+		} else if len(args) > 0 {
+			arg, found = fieldValue(args[0], name)
+			if !found {
+				errs = append(errs, fmt.Errorf("plural count %v is missing in arguments", name))
+			}
+		}
+
+		if found && !isNumber(arg) {
+			errs = append(errs, fmt.Errorf("plural count %v should be a number, but got %T", name, arg))
+		}
+	}
+	return errs
+}
+
+func fieldValue(data interface{}, name string) (interface{}, bool) {
+	value := reflect.Indirect(reflect.ValueOf(data))
+	switch value.Kind() {
+	case reflect.Map:
+		if value.Type().Key().Kind() == reflect.String {
+			if v := value.MapIndex(reflect.ValueOf(name).Convert(value.Type().Key())); v.IsValid() {
+				return v.Interface(), true
+			}
+		}
+	case reflect.Struct:
+		if v := value.FieldByName(name); v.IsValid() && v.CanInterface() {
+			return v.Interface(), true
+		}
+	}
+	return nil, false
+}
+
+func isNumber(value interface{}) bool {
+	switch reflect.Indirect(reflect.ValueOf(value)).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
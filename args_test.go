@@ -0,0 +1,137 @@
+package i18n
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDebugArgs(t *testing.T) {
+	var errs []string
+	i18n := New(&backend{})
+	i18n.OnParseError = func(locale, key, value string, err error) {
+		errs = append(errs, key+": "+err.Error())
+	}
+	i18n.AddTranslation(&Translation{Key: "count", Locale: "en-US", Value: `{{p "Count" (one "{{.Count}} item") (other "{{.Count}} items")}}`})
+	i18n.AddTranslation(&Translation{Key: "ordered", Locale: "en-US", Value: "{{$1}} and {{$2}}"})
+
+	i18n.T("en-US", "count", map[string]interface{}{"Count": "1"})
+	if len(errs) != 0 {
+		t.Errorf("mismatched args shouldn't be reported if not in debug mode, but got %v", errs)
+	}
+
+	i18n.Debug = true
+	i18n.T("en-US", "count", map[string]interface{}{"Count": 1})
+	i18n.T("en-US", "ordered", "one", "two")
+	if len(errs) != 0 {
+		t.Errorf("matched args shouldn't be reported, but got %v", errs)
+	}
+
+	i18n.T("en-US", "count", map[string]interface{}{"Count": "1"})
+	if len(errs) != 1 || !strings.Contains(errs[0], "count: plural count Count should be a number") {
+		t.Errorf("string plural count should be reported, but got %v", errs)
+	}
+
+	errs = nil
+	i18n.T("en-US", "ordered", "one")
+	if len(errs) == 0 || !strings.Contains(errs[0], "ordered: placeholder $2 has no argument") {
+		t.Errorf("missing positional arg should be reported, but got %v", errs)
+	}
+}
+
+func TestValidateArgs(t *testing.T) {
+	type Cart struct{ Count int }
+
+	if errs := validateArgs(`{{p "Count" (one "item") (other "items")}}`, []interface{}{Cart{Count: 2}}); len(errs) != 0 {
+		t.Errorf("struct field count should be valid, but got %v", errs)
+	}
+
+	if errs := validateArgs(`{{p $1 (one "item") (other "items")}}`, []interface{}{"2"}); len(errs) != 1 {
+		t.Errorf("string positional count should be invalid, but got %v", errs)
+	}
+
+	if errs := validateArgs(`{{p "Total" (one "item") (other "items")}}`, []interface{}{Cart{Count: 2}}); len(errs) != 1 {
+		t.Errorf("missing count field should be invalid, but got %v", errs)
+	}
+}
+
+func TestMaxPlaceholders(t *testing.T) {
+	i18n := New(&backend{})
+	logger := &testLogger{}
+	i18n.SetLogger(logger)
+	i18n.AddTranslation(&Translation{Key: "cldr", Locale: "en-US", Value: "{{$1}} {{$2}} {{$3}}"})
+	i18n.AddTranslation(&Translation{Key: "sprintf", Locale: "en-US", Value: "%s %d%% %[2]v"})
+
+	if result := i18n.T("en-US", "cldr", "one"); result != "{{$1}} {{$2}} {{$3}}" {
+		t.Errorf("translation referencing missing arguments should be returned as it is, but got %v", result)
+	}
+
+	i18n.SetMaxPlaceholders(2)
+	if result := i18n.T("en-US", "cldr", "one", "two", "three"); result != "{{$1}} {{$2}} {{$3}}" {
+		t.Errorf("translation exceeds max placeholders shouldn't be formatted, but got %v", result)
+	}
+
+	if len(logger.messages) != 1 || !strings.Contains(logger.messages[0], "exceeds the max 2") {
+		t.Errorf("exceeded placeholders should be logged, but got %v", logger.messages)
+	}
+
+	i18n.SetFormatter(SprintfFormatter)
+	if result := i18n.T("en-US", "sprintf", "a", 1); result != "%s %d%% %[2]v" {
+		t.Errorf("sprintf verbs should be counted as placeholders, but got %v", result)
+	}
+
+	i18n.SetMaxPlaceholders(3)
+	var parseErrs []error
+	i18n.OnParseError = func(locale, key, value string, err error) {
+		parseErrs = append(parseErrs, err)
+	}
+	if result := i18n.T("en-US", "sprintf", "a"); result != "%s %d%% %[2]v" {
+		t.Errorf("translation referencing missing arguments should be returned as it is, but got %v", result)
+	}
+
+	if len(parseErrs) != 1 || !strings.Contains(parseErrs[0].Error(), "argument 2, but 1 arguments given") {
+		t.Errorf("missing arguments should be reported, but got %v", parseErrs)
+	}
+}
+
+func TestReplaceUnfilled(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello {{$1}}!"})
+	i18n.AddTranslation(&Translation{Key: "named", Locale: "en-US", Value: "Hello {{.Name}}!"})
+	i18n.AddTranslation(&Translation{Key: "sprintf", Locale: "en-US", Value: "Hello %s, 100%% %d"})
+
+	if result := i18n.T("en-US", "hello"); result != "Hello {{$1}}!" {
+		t.Errorf("unfilled placeholders should be kept by default, but got %v", result)
+	}
+
+	i18n.ReplaceUnfilled = true
+	if result := i18n.T("en-US", "hello"); result != "Hello !" {
+		t.Errorf("unfilled placeholders should be replaced with blank, but got %v", result)
+	}
+
+	if result := i18n.T("en-US", "named", map[string]interface{}{}); result != "Hello !" {
+		t.Errorf("unfilled named placeholders should be replaced with blank, but got %v", result)
+	}
+
+	i18n.UnfilledToken = "?"
+	if result := i18n.T("en-US", "hello", "Jinzhu"); result != "Hello Jinzhu!" {
+		t.Errorf("filled placeholders shouldn't be affected, but got %v", result)
+	}
+
+	if result := i18n.T("en-US", "hello", "{{.Name}}"); result != "Hello {{.Name}}!" {
+		t.Errorf("template actions in arguments shouldn't be replaced, but got %v", result)
+	}
+
+	i18n.SetFormatter(SprintfFormatter)
+	if result := i18n.T("en-US", "sprintf"); result != "Hello ?, 100%% ?" {
+		t.Errorf("unfilled verbs should be replaced with token, but got %v", result)
+	}
+
+	if result := i18n.T("en-US", "sprintf", "Jinzhu"); result != "Hello Jinzhu, 100% ?" {
+		t.Errorf("missing arguments should be replaced with token, but got %v", result)
+	}
+
+	i18n.AddTranslation(&Translation{Key: "percent", Locale: "en-US", Value: "50%%off %s"})
+	if result := i18n.T("en-US", "percent", "now"); result != "50%off now" {
+		t.Errorf("literal percent signs shouldn't be replaced, but got %v", result)
+	}
+}
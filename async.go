@@ -0,0 +1,89 @@
+package i18n
+
+import "sync"
+
+// autoCreateQueue queue of translations to be auto created in background, writes for same locale and key are coalesced
+// The worker goroutine is started when translations are queued, and exits once the queue is drained
+type autoCreateQueue struct {
+	mutex   sync.Mutex
+	idle    *sync.Cond
+	pending map[string]bool
+	jobs    []autoCreateJob
+	waiting int
+	working bool
+}
+
+type autoCreateJob struct {
+	key    string
+	create func()
+}
+
+func newAutoCreateQueue() *autoCreateQueue {
+	queue := &autoCreateQueue{pending: map[string]bool{}}
+	queue.idle = sync.NewCond(&queue.mutex)
+	return queue
+}
+
+// autoCreateAsync queue translation to be auto created in background, it is skipped if the same translation is waiting to be created
+func (i18n *I18n) autoCreateAsync(translation *Translation) {
+	if i18n.autoCreates == nil {
+		i18n.autoCreate(translation)
+		return
+	}
+
+	var (
+		queue = i18n.autoCreates
+		key   = i18n.cacheKeyFor(translation.Locale, translation.Key)
+	)
+
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	if queue.pending[key] {
+		return
+	}
+
+	queue.pending[key] = true
+	queue.waiting++
+	queue.jobs = append(queue.jobs, autoCreateJob{key: key, create: func() { i18n.autoCreate(translation) }})
+
+	if !queue.working {
+		queue.working = true
+		go queue.work()
+	}
+}
+
+func (queue *autoCreateQueue) work() {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	for len(queue.jobs) > 0 {
+		jobs := queue.jobs
+		queue.jobs = nil
+
+		queue.mutex.Unlock()
+		for _, job := range jobs {
+			job.create()
+
+			queue.mutex.Lock()
+			delete(queue.pending, job.key)
+			if queue.waiting--; queue.waiting == 0 {
+				queue.idle.Broadcast()
+			}
+			queue.mutex.Unlock()
+		}
+		queue.mutex.Lock()
+	}
+	queue.working = false
+}
+
+// FlushAutoCreate wait until all translations queued in AsyncAutoCreate mode are created, it could be called before shutting down
+// It is safe to call it while translations are still being queued
+func (i18n *I18n) FlushAutoCreate() {
+	if queue := i18n.autoCreates; queue != nil {
+		queue.mutex.Lock()
+		for queue.waiting > 0 {
+			queue.idle.Wait()
+		}
+		queue.mutex.Unlock()
+	}
+}
@@ -0,0 +1,101 @@
+package i18n
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+type slowBackend struct {
+	mutex   sync.Mutex
+	release chan struct{}
+	saved   []*Translation
+}
+
+func (b *slowBackend) LoadTranslations() []*Translation { return nil }
+func (b *slowBackend) SaveTranslation(t *Translation) error {
+	<-b.release
+	b.mutex.Lock()
+	b.saved = append(b.saved, t)
+	b.mutex.Unlock()
+	return nil
+}
+func (b *slowBackend) DeleteTranslation(t *Translation) error { return nil }
+
+func TestAsyncAutoCreate(t *testing.T) {
+	backend := &slowBackend{release: make(chan struct{})}
+	i18n := New(backend)
+	i18n.AsyncAutoCreate = true
+
+	done := make(chan bool)
+	go func() {
+		for i := 0; i < 3; i++ {
+			i18n.T("en-US", "hello")
+		}
+		i18n.T("en-US", "bye")
+		done <- true
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("T shouldn't wait for backends in AsyncAutoCreate mode")
+	}
+
+	close(backend.release)
+	i18n.FlushAutoCreate()
+
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	if len(backend.saved) != 2 {
+		t.Fatalf("duplicate writes should be coalesced, got %v", len(backend.saved))
+	}
+	if backend.saved[0].Key != "hello" || !backend.saved[0].Auto || backend.saved[1].Key != "bye" {
+		t.Errorf("missing translations should be auto created in order, got %v, %v", backend.saved[0], backend.saved[1])
+	}
+
+	if _, ok := i18n.Raw("en-US", "hello"); !ok {
+		t.Errorf("auto created translation should be cached")
+	}
+}
+
+func TestFlushAutoCreateWhileTranslating(t *testing.T) {
+	backend := &slowBackend{release: make(chan struct{})}
+	close(backend.release)
+	i18n := New(backend)
+	i18n.AsyncAutoCreate = true
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				i18n.T("en-US", fmt.Sprintf("key%v-%v", i, j))
+			}
+		}(i)
+		i18n.FlushAutoCreate()
+	}
+	wg.Wait()
+	i18n.FlushAutoCreate()
+
+	backend.mutex.Lock()
+	saved := len(backend.saved)
+	backend.mutex.Unlock()
+	if saved != 1000 {
+		t.Errorf("all queued translations should be created after flush, got %v", saved)
+	}
+
+	for deadline := time.Now().Add(time.Second); ; time.Sleep(time.Millisecond) {
+		i18n.autoCreates.mutex.Lock()
+		working := i18n.autoCreates.working
+		i18n.autoCreates.mutex.Unlock()
+		if !working {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("worker should exit when there is nothing to create")
+		}
+	}
+}
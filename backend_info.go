@@ -0,0 +1,38 @@
+package i18n
+
+import "fmt"
+
+// ReadOnlyBackend is an optional interface for backends that declare whether translations could be saved into them
+type ReadOnlyBackend interface {
+	ReadOnly() bool
+}
+
+// NamedBackend is an optional interface for backends that have a display name
+type NamedBackend interface {
+	BackendName() string
+}
+
+// BackendInfo name and capability of a backend
+type BackendInfo struct {
+	Name     string
+	Writable bool
+	Backend  Backend
+}
+
+// ListBackends return info of backends in the same order as `Backends`, it could be used by admin UIs to disable editing translations of read-only backends
+// Backends are writable unless they implement ReadOnlyBackend and declare to be read-only, backends' names default to their types
+func (i18n *I18n) ListBackends() []BackendInfo {
+	var infos []BackendInfo
+	for _, backend := range i18n.Backends {
+		info := BackendInfo{Name: fmt.Sprintf("%T", backend), Writable: true, Backend: backend}
+		if named, ok := backend.(NamedBackend); ok {
+			info.Name = named.BackendName()
+		}
+
+		if readOnly, ok := backend.(ReadOnlyBackend); ok {
+			info.Writable = !readOnly.ReadOnly()
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
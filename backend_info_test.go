@@ -0,0 +1,36 @@
+package i18n
+
+import (
+	"reflect"
+	"testing"
+)
+
+type readOnlyBackend struct {
+	sliceBackend
+}
+
+func (readOnlyBackend) ReadOnly() bool {
+	return true
+}
+
+func (readOnlyBackend) BackendName() string {
+	return "embed"
+}
+
+func TestListBackends(t *testing.T) {
+	i18n := New(&readOnlyBackend{}, &backend{})
+
+	var results []BackendInfo
+	for _, info := range i18n.ListBackends() {
+		results = append(results, BackendInfo{Name: info.Name, Writable: info.Writable})
+	}
+
+	expected := []BackendInfo{{Name: "embed", Writable: false}, {Name: "*i18n.backend", Writable: true}}
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("backends should be %v, but got %v", expected, results)
+	}
+
+	if info := i18n.ListBackends()[1]; info.Backend != i18n.Backends[1] {
+		t.Errorf("should return the backend")
+	}
+}
@@ -2,6 +2,7 @@ package database
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/jinzhu/gorm"
 	"github.com/qor/i18n"
@@ -9,9 +10,11 @@ import (
 
 // Translation is a struct used to save translations into databae
 type Translation struct {
-	Locale string `sql:"size:12;"`
-	Key    string `sql:"size:4294967295;"`
-	Value  string `sql:"size:4294967295"`
+	Locale    string `sql:"size:12;"`
+	Key       string `sql:"size:4294967295;"`
+	Value     string `sql:"size:4294967295"`
+	UpdatedAt time.Time
+	Auto      bool
 }
 
 // New new DB backend for I18n
@@ -28,19 +31,60 @@ type Backend struct {
 	DB *gorm.DB
 }
 
+// Ping check database of DB backend is reachable
+func (backend *Backend) Ping() error {
+	return backend.DB.DB().Ping()
+}
+
 // LoadTranslations load translations from DB backend
 func (backend *Backend) LoadTranslations() (translations []*i18n.Translation) {
 	backend.DB.Find(&translations)
 	return translations
 }
 
+// LoadTranslation load translation of locale and key from DB backend, it returns nil if there is no such translation
+func (backend *Backend) LoadTranslation(locale, key string) (*i18n.Translation, error) {
+	var translation i18n.Translation
+	if err := backend.DB.Where(Translation{Key: key, Locale: locale}).First(&translation).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &translation, nil
+}
+
+// LoadTranslationsOf load translations of locale from DB backend
+func (backend *Backend) LoadTranslationsOf(locale string) (translations []*i18n.Translation, err error) {
+	err = backend.DB.Where(Translation{Locale: locale}).Find(&translations).Error
+	return translations, err
+}
+
 // SaveTranslation save translation into DB backend
 func (backend *Backend) SaveTranslation(t *i18n.Translation) error {
 	return backend.DB.Where(Translation{Key: t.Key, Locale: t.Locale}).
-		Assign(Translation{Value: t.Value}).
+		Assign(map[string]interface{}{"value": t.Value, "auto": t.Auto}).
 		FirstOrCreate(&Translation{}).Error
 }
 
+// SaveTranslations save translations into DB backend in one transaction
+func (backend *Backend) SaveTranslations(translations []*i18n.Translation) error {
+	tx := backend.DB.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	for _, t := range translations {
+		if err := tx.Where(Translation{Key: t.Key, Locale: t.Locale}).
+			Assign(map[string]interface{}{"value": t.Value, "auto": t.Auto}).
+			FirstOrCreate(&Translation{}).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit().Error
+}
+
 // DeleteTranslation delete translation into DB backend
 func (backend *Backend) DeleteTranslation(t *i18n.Translation) error {
 	return backend.DB.Where(Translation{Key: t.Key, Locale: t.Locale}).Delete(&Translation{}).Error
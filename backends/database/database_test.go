@@ -45,3 +45,21 @@ func TestTranslations(t *testing.T) {
 		t.Errorf("should has one translation left")
 	}
 }
+
+func TestSaveTranslations(t *testing.T) {
+	db.Delete(&database.Translation{})
+
+	transactionalBackend, ok := backend.(i18n.TransactionalBackend)
+	if !ok {
+		t.Fatalf("DB backend should support saving translations in transaction")
+	}
+
+	err := transactionalBackend.SaveTranslations([]*i18n.Translation{
+		{Key: "hello", Value: "Hello", Locale: "en-US"},
+		{Key: "bye", Value: "Bye", Locale: "en-US"},
+	})
+
+	if err != nil || len(backend.LoadTranslations()) != 2 {
+		t.Errorf("should save two translations, but got %v", err)
+	}
+}
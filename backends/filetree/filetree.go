@@ -0,0 +1,133 @@
+package filetree
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/qor/i18n"
+)
+
+var _ i18n.Backend = &Backend{}
+
+// New new file tree backend for I18n, it treats each file as a translation
+// Files are organized with locale folders, path of the file will be used as key, e.g: `<dir>/en-US/home/title.txt` => key `home.title` of locale `en-US`
+// Dots of folder and file names are escaped with `i18n.EscapeKey`, e.g: `<dir>/en-US/api/v1.2/title.txt` => key `api.v1\.2.title`
+func New(dir string) *Backend {
+	return &Backend{Dir: dir}
+}
+
+// Backend file tree backend
+type Backend struct {
+	Dir string
+	// Extension file extension used when saving new translations
+	Extension string
+}
+
+// Ping check directory of file tree backend exists
+func (backend *Backend) Ping() error {
+	_, err := os.Stat(backend.Dir)
+	return err
+}
+
+// LoadTranslations load translations from file tree backend
+func (backend *Backend) LoadTranslations() (translations []*i18n.Translation) {
+	locales, err := ioutil.ReadDir(backend.Dir)
+	if err != nil {
+		return nil
+	}
+
+	for _, locale := range locales {
+		if !locale.IsDir() || strings.HasPrefix(locale.Name(), ".") {
+			continue
+		}
+
+		localeDir := filepath.Join(backend.Dir, locale.Name())
+		filepath.Walk(localeDir, func(path string, fileInfo os.FileInfo, err error) error {
+			if err != nil || !fileInfo.Mode().IsRegular() || strings.HasPrefix(fileInfo.Name(), ".") {
+				return nil
+			}
+
+			if content, err := ioutil.ReadFile(path); err == nil {
+				translations = append(translations, &i18n.Translation{
+					Locale: locale.Name(),
+					Key:    pathToKey(localeDir, path),
+					Value:  strings.TrimSuffix(string(content), "\n"),
+				})
+			}
+			return nil
+		})
+	}
+	return translations
+}
+
+func pathToKey(localeDir, path string) string {
+	relativePath, _ := filepath.Rel(localeDir, path)
+	relativePath = strings.TrimSuffix(relativePath, filepath.Ext(relativePath))
+
+	var segments []string
+	for _, segment := range strings.Split(filepath.ToSlash(relativePath), "/") {
+		segments = append(segments, i18n.EscapeKey(segment))
+	}
+	return strings.Join(segments, i18n.KeySeparator)
+}
+
+// findFile find existing file of the translation, or return a new file path for it
+// Files are matched by name without extension, so names with glob metacharacters like `[` are found as they are
+func (backend *Backend) findFile(t *i18n.Translation) (string, bool) {
+	path := filepath.Join(append([]string{backend.Dir, t.Locale}, i18n.SplitKey(t.Key)...)...)
+	if fileInfos, err := ioutil.ReadDir(filepath.Dir(path)); err == nil {
+		for _, fileInfo := range fileInfos {
+			name := fileInfo.Name()
+			if fileInfo.Mode().IsRegular() && strings.TrimSuffix(name, filepath.Ext(name)) == filepath.Base(path) {
+				return filepath.Join(filepath.Dir(path), name), true
+			}
+		}
+	}
+	return path + backend.Extension, false
+}
+
+func validTranslation(t *i18n.Translation) error {
+	if t.Locale == "" || t.Key == "" || strings.Contains(t.Locale, "..") || strings.ContainsAny(t.Locale, `/\`) {
+		return errors.New("invalid locale or key for file tree backend")
+	}
+
+	for _, segment := range i18n.SplitKey(t.Key) {
+		if segment == "" || strings.HasPrefix(segment, ".") || strings.ContainsAny(segment, `/\`) {
+			return errors.New("invalid locale or key for file tree backend")
+		}
+	}
+	return nil
+}
+
+// SaveTranslation save translation into file tree backend
+func (backend *Backend) SaveTranslation(t *i18n.Translation) error {
+	if err := validTranslation(t); err != nil {
+		return err
+	}
+
+	path, found := backend.findFile(t)
+	if !found && backend.Extension == "" && filepath.Ext(path) != "" {
+		// the part after the last dot would be treated as extension when loading
+		return errors.New("file tree backend requires Extension to save key with dotted segment")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, []byte(t.Value), 0644)
+}
+
+// DeleteTranslation delete translation from file tree backend
+func (backend *Backend) DeleteTranslation(t *i18n.Translation) error {
+	if err := validTranslation(t); err != nil {
+		return err
+	}
+
+	if path, ok := backend.findFile(t); ok {
+		return os.Remove(path)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package filetree_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/qor/i18n"
+	"github.com/qor/i18n/backends/filetree"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func findTranslation(translations []*i18n.Translation, locale, key string) *i18n.Translation {
+	for _, translation := range translations {
+		if translation.Locale == locale && translation.Key == key {
+			return translation
+		}
+	}
+	return nil
+}
+
+func TestLoadTranslations(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "filetree")
+	defer os.RemoveAll(dir)
+
+	writeFile(t, filepath.Join(dir, "en-US", "home", "title.txt"), "Welcome\n")
+	writeFile(t, filepath.Join(dir, "en-US", "legal", "terms.md"), "# Terms\n\nBe nice.")
+	writeFile(t, filepath.Join(dir, "zh-CN", "home", "title.txt"), "欢迎")
+
+	backend := filetree.New(dir)
+	translations := backend.LoadTranslations()
+	if len(translations) != 3 {
+		t.Errorf("should load 3 translations, but got %v", len(translations))
+	}
+
+	for _, result := range [][]string{{"en-US", "home.title", "Welcome"}, {"en-US", "legal.terms", "# Terms\n\nBe nice."}, {"zh-CN", "home.title", "欢迎"}} {
+		if translation := findTranslation(translations, result[0], result[1]); translation == nil || translation.Value != result[2] {
+			t.Errorf("should load %v for %v, but got %v", result[1], result[0], translation)
+		}
+	}
+}
+
+func TestSaveAndDeleteTranslation(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "filetree")
+	defer os.RemoveAll(dir)
+
+	writeFile(t, filepath.Join(dir, "en-US", "home", "title.txt"), "Welcome")
+
+	backend := filetree.New(dir)
+	backend.Extension = ".txt"
+
+	if err := backend.SaveTranslation(&i18n.Translation{Locale: "en-US", Key: "home.title", Value: "Hello"}); err != nil {
+		t.Fatal(err)
+	}
+	if content, _ := ioutil.ReadFile(filepath.Join(dir, "en-US", "home", "title.txt")); string(content) != "Hello" {
+		t.Errorf("existing file should be updated, but got %v", string(content))
+	}
+
+	if err := backend.SaveTranslation(&i18n.Translation{Locale: "de-DE", Key: "user.profile.name", Value: "Name"}); err != nil {
+		t.Fatal(err)
+	}
+	if content, _ := ioutil.ReadFile(filepath.Join(dir, "de-DE", "user", "profile", "name.txt")); string(content) != "Name" {
+		t.Errorf("new file should be created, but got %v", string(content))
+	}
+
+	if translation := findTranslation(backend.LoadTranslations(), "de-DE", "user.profile.name"); translation == nil || translation.Value != "Name" {
+		t.Errorf("saved translation should be loaded, but got %v", translation)
+	}
+
+	if err := backend.DeleteTranslation(&i18n.Translation{Locale: "en-US", Key: "home.title"}); err != nil {
+		t.Fatal(err)
+	}
+	if translation := findTranslation(backend.LoadTranslations(), "en-US", "home.title"); translation != nil {
+		t.Errorf("translation should be deleted")
+	}
+
+	if err := backend.SaveTranslation(&i18n.Translation{Locale: "../en-US", Key: "hack", Value: "Hack"}); err == nil {
+		t.Errorf("should reject locale that escapes the directory")
+	}
+}
+
+func TestDottedAndGlobFileNames(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "filetree")
+	defer os.RemoveAll(dir)
+
+	writeFile(t, filepath.Join(dir, "en-US", "api", "v1.2", "title.txt"), "API v1.2")
+	writeFile(t, filepath.Join(dir, "en-US", "docs", "v2.0.md"), "Docs v2.0")
+	writeFile(t, filepath.Join(dir, "en-US", "home", "[beta].txt"), "Beta")
+
+	backend := filetree.New(dir)
+	translations := backend.LoadTranslations()
+	for _, result := range [][]string{{`api.v1\.2.title`, "API v1.2"}, {`docs.v2\.0`, "Docs v2.0"}, {"home.[beta]", "Beta"}} {
+		if translation := findTranslation(translations, "en-US", result[0]); translation == nil || translation.Value != result[1] {
+			t.Errorf("should load %v as %v, but got %v", result[0], result[1], translation)
+		}
+	}
+
+	for _, key := range []string{`api.v1\.2.title`, `docs.v2\.0`, "home.[beta]"} {
+		if err := backend.SaveTranslation(&i18n.Translation{Locale: "en-US", Key: key, Value: "Updated"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	translations = backend.LoadTranslations()
+	if len(translations) != 3 {
+		t.Errorf("saving loaded keys should update existing files, but got %v translations", len(translations))
+	}
+	for _, translation := range translations {
+		if translation.Value != "Updated" {
+			t.Errorf("translation %v should be updated, but got %v", translation.Key, translation.Value)
+		}
+	}
+
+	if err := backend.SaveTranslation(&i18n.Translation{Locale: "en-US", Key: `docs.v3\.0`, Value: "Docs v3.0"}); err == nil {
+		t.Errorf("should reject new file with dotted name if Extension is blank")
+	}
+	backend.Extension = ".txt"
+	if err := backend.SaveTranslation(&i18n.Translation{Locale: "en-US", Key: `docs.v3\.0`, Value: "Docs v3.0"}); err != nil {
+		t.Fatal(err)
+	}
+	if translation := findTranslation(backend.LoadTranslations(), "en-US", `docs.v3\.0`); translation == nil || translation.Value != "Docs v3.0" {
+		t.Errorf("saved dotted key should round-trip, but got %v", translation)
+	}
+}
@@ -0,0 +1,188 @@
+package frontmatter
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/qor/i18n"
+	"gopkg.in/yaml.v2"
+)
+
+var _ i18n.Backend = &Backend{}
+
+// New new front-matter backend for I18n, it treats each Markdown file as a translation
+// Files are organized with locale folders like file tree backend, e.g: `<dir>/en-US/legal/terms.md` => key `legal.terms` of locale `en-US`
+// YAML front-matter of the file is used as metadata of the translation, and the body is used as value, e.g:
+//
+//	---
+//	comment: Shown in the sign up page
+//	fuzzy: true
+//	---
+//	# Terms of Service
+func New(dir string) *Backend {
+	return &Backend{Dir: dir}
+}
+
+// Backend front-matter backend
+type Backend struct {
+	Dir string
+}
+
+// frontMatter metadata of a translation file, status `fuzzy` or `draft` marks the translation as fuzzy too
+type frontMatter struct {
+	Title   string `yaml:"title,omitempty"`
+	Status  string `yaml:"status,omitempty"`
+	Comment string `yaml:"comment,omitempty"`
+	Fuzzy   bool   `yaml:"fuzzy,omitempty"`
+}
+
+const delimiter = "---"
+
+// Ping check directory of front-matter backend exists
+func (backend *Backend) Ping() error {
+	_, err := os.Stat(backend.Dir)
+	return err
+}
+
+// LoadTranslations load translations from front-matter backend
+func (backend *Backend) LoadTranslations() []*i18n.Translation {
+	translations, _ := backend.LoadTranslationsE()
+	return translations
+}
+
+// LoadTranslationsE load translations from front-matter backend, files have invalid front-matter are skipped and reported
+func (backend *Backend) LoadTranslationsE() (translations []*i18n.Translation, err error) {
+	locales, err := ioutil.ReadDir(backend.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []string
+	for _, locale := range locales {
+		if !locale.IsDir() || strings.HasPrefix(locale.Name(), ".") {
+			continue
+		}
+
+		localeDir := filepath.Join(backend.Dir, locale.Name())
+		filepath.Walk(localeDir, func(path string, fileInfo os.FileInfo, err error) error {
+			if err != nil || !fileInfo.Mode().IsRegular() || filepath.Ext(path) != ".md" {
+				return nil
+			}
+
+			content, err := ioutil.ReadFile(path)
+			if err != nil {
+				messages = append(messages, err.Error())
+				return nil
+			}
+
+			meta, body, err := parse(content)
+			if err != nil {
+				messages = append(messages, path+": "+err.Error())
+				return nil
+			}
+
+			translations = append(translations, &i18n.Translation{
+				Locale:  locale.Name(),
+				Key:     pathToKey(localeDir, path),
+				Value:   body,
+				Comment: meta.Comment,
+				Fuzzy:   meta.Fuzzy || meta.Status == "fuzzy" || meta.Status == "draft",
+			})
+			return nil
+		})
+	}
+
+	if len(messages) > 0 {
+		return translations, errors.New(strings.Join(messages, "; "))
+	}
+	return translations, nil
+}
+
+// parse split content into front-matter and body, content without front-matter is treated as body
+func parse(content []byte) (meta frontMatter, body string, err error) {
+	lines := strings.Split(strings.Replace(string(content), "\r\n", "\n", -1), "\n")
+	if lines[0] != delimiter {
+		return meta, strings.TrimRight(strings.Join(lines, "\n"), "\n"), nil
+	}
+
+	for idx := 1; idx < len(lines); idx++ {
+		if lines[idx] == delimiter {
+			if err = yaml.Unmarshal([]byte(strings.Join(lines[1:idx], "\n")), &meta); err != nil {
+				return meta, "", err
+			}
+			return meta, strings.TrimRight(strings.Join(lines[idx+1:], "\n"), "\n"), nil
+		}
+	}
+	return meta, "", errors.New("front-matter isn't closed")
+}
+
+func pathToKey(localeDir, path string) string {
+	relativePath, _ := filepath.Rel(localeDir, path)
+	relativePath = strings.TrimSuffix(relativePath, filepath.Ext(relativePath))
+	return strings.Join(strings.Split(filepath.ToSlash(relativePath), "/"), ".")
+}
+
+func (backend *Backend) filePath(t *i18n.Translation) (string, error) {
+	if t.Locale == "" || t.Key == "" || strings.Contains(t.Locale, "..") || strings.Contains(t.Key, "..") ||
+		strings.ContainsAny(t.Locale, `/\`) || strings.ContainsAny(t.Key, `/\`) {
+		return "", errors.New("invalid locale or key for front-matter backend")
+	}
+	return filepath.Join(append([]string{backend.Dir, t.Locale}, strings.Split(t.Key, ".")...)...) + ".md", nil
+}
+
+// SaveTranslation save translation into front-matter backend, title and status of existing front-matter are preserved
+// Existing comment is kept if comment of translation is blank, e.g: translations saved from admin or auto created
+func (backend *Backend) SaveTranslation(t *i18n.Translation) error {
+	path, err := backend.filePath(t)
+	if err != nil {
+		return err
+	}
+
+	var meta frontMatter
+	if content, err := ioutil.ReadFile(path); err == nil {
+		meta, _, _ = parse(content)
+	}
+
+	if t.Comment != "" {
+		meta.Comment = t.Comment
+	}
+	meta.Fuzzy = t.Fuzzy
+	if !t.Fuzzy && (meta.Status == "fuzzy" || meta.Status == "draft") {
+		meta.Status = ""
+	}
+
+	var buf bytes.Buffer
+	if meta != (frontMatter{}) {
+		header, err := yaml.Marshal(meta)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(delimiter + "\n")
+		buf.Write(header)
+		buf.WriteString(delimiter + "\n")
+	}
+	buf.WriteString(t.Value)
+	buf.WriteString("\n")
+
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, buf.Bytes(), 0644)
+}
+
+// DeleteTranslation delete translation from front-matter backend
+func (backend *Backend) DeleteTranslation(t *i18n.Translation) error {
+	path, err := backend.filePath(t)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
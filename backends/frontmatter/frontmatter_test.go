@@ -0,0 +1,119 @@
+package frontmatter_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/qor/i18n"
+	"github.com/qor/i18n/backends/frontmatter"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func findTranslation(translations []*i18n.Translation, locale, key string) *i18n.Translation {
+	for _, translation := range translations {
+		if translation.Locale == locale && translation.Key == key {
+			return translation
+		}
+	}
+	return nil
+}
+
+func TestLoadTranslations(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "frontmatter")
+	defer os.RemoveAll(dir)
+
+	writeFile(t, filepath.Join(dir, "en-US", "legal", "terms.md"), "---\ntitle: Terms of Service\ncomment: \"Shown in the sign up page\"\nfuzzy: true\n---\n# Terms\n\nBe nice.\n")
+	writeFile(t, filepath.Join(dir, "zh-CN", "legal", "terms.md"), "---\r\nstatus: draft\r\n---\r\n# 条款\r\n")
+	writeFile(t, filepath.Join(dir, "en-US", "home", "intro.md"), "Welcome to --- QOR\n")
+	writeFile(t, filepath.Join(dir, "en-US", "home", "notes.txt"), "not a translation")
+
+	translations, err := frontmatter.New(dir).LoadTranslationsE()
+	if err != nil {
+		t.Fatalf("failed to load translations, got %v", err)
+	}
+	if len(translations) != 3 {
+		t.Errorf("should only load markdown files, got %v", len(translations))
+	}
+
+	terms := findTranslation(translations, "en-US", "legal.terms")
+	if terms == nil || terms.Value != "# Terms\n\nBe nice." || terms.Comment != "Shown in the sign up page" || !terms.Fuzzy {
+		t.Errorf("failed to load translation with front-matter, got %#v", terms)
+	}
+
+	if terms := findTranslation(translations, "zh-CN", "legal.terms"); terms == nil || terms.Value != "# 条款" || !terms.Fuzzy {
+		t.Errorf("draft status should mark translation as fuzzy, got %#v", terms)
+	}
+
+	if intro := findTranslation(translations, "en-US", "home.intro"); intro == nil || intro.Value != "Welcome to --- QOR" || intro.Fuzzy {
+		t.Errorf("file without front-matter should be loaded as body, got %#v", intro)
+	}
+}
+
+func TestLoadInvalidFrontMatter(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "frontmatter")
+	defer os.RemoveAll(dir)
+
+	writeFile(t, filepath.Join(dir, "en-US", "broken.md"), "---\ncomment: never closed\nbody")
+	writeFile(t, filepath.Join(dir, "en-US", "hello.md"), "Hello")
+
+	translations, err := frontmatter.New(dir).LoadTranslationsE()
+	if err == nil || !strings.Contains(err.Error(), "broken.md") {
+		t.Errorf("should report file with invalid front-matter, got %v", err)
+	}
+	if len(translations) != 1 || translations[0].Key != "hello" {
+		t.Errorf("valid files should still be loaded, got %v", translations)
+	}
+}
+
+func TestSaveAndDeleteTranslation(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "frontmatter")
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "en-US", "legal", "terms.md")
+	writeFile(t, path, "---\ntitle: Terms of Service\nstatus: fuzzy\n---\nOld terms\n")
+
+	backend := frontmatter.New(dir)
+	if err := backend.SaveTranslation(&i18n.Translation{Locale: "en-US", Key: "legal.terms", Value: "New terms", Comment: "Reviewed"}); err != nil {
+		t.Fatalf("failed to save translation, got %v", err)
+	}
+
+	translation := findTranslation(backend.LoadTranslations(), "en-US", "legal.terms")
+	if translation == nil || translation.Value != "New terms" || translation.Comment != "Reviewed" || translation.Fuzzy {
+		t.Errorf("translation should be updated, got %#v", translation)
+	}
+	if content, _ := ioutil.ReadFile(path); !strings.Contains(string(content), "Terms of Service") {
+		t.Errorf("title of front-matter should be preserved, got %s", content)
+	}
+
+	backend.SaveTranslation(&i18n.Translation{Locale: "en-US", Key: "legal.terms", Value: "Newer terms"})
+	if translation := findTranslation(backend.LoadTranslations(), "en-US", "legal.terms"); translation == nil || translation.Value != "Newer terms" || translation.Comment != "Reviewed" {
+		t.Errorf("comment should be kept when saving translation without comment, got %#v", translation)
+	}
+
+	backend.SaveTranslation(&i18n.Translation{Locale: "zh-CN", Key: "hello", Value: "你好"})
+	if content, _ := ioutil.ReadFile(filepath.Join(dir, "zh-CN", "hello.md")); string(content) != "你好\n" {
+		t.Errorf("translation without metadata should be saved without front-matter, got %q", content)
+	}
+
+	if err := backend.SaveTranslation(&i18n.Translation{Locale: "en-US", Key: "../escape", Value: "x"}); err == nil {
+		t.Errorf("should reject invalid key")
+	}
+
+	if err := backend.DeleteTranslation(&i18n.Translation{Locale: "en-US", Key: "legal.terms"}); err != nil {
+		t.Fatalf("failed to delete translation, got %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("file should be deleted")
+	}
+}
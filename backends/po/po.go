@@ -0,0 +1,201 @@
+package po
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/qor/i18n"
+)
+
+var _ i18n.Backend = &Backend{}
+
+// New new gettext PO backend for I18n, translations are loaded from `<locale>.po` files under dir every time `LoadTranslations` is called
+// msgid will be used as translation key, msgstr as value
+func New(dir string) *Backend {
+	return &Backend{Dir: dir}
+}
+
+// Backend gettext PO backend
+type Backend struct {
+	Dir string
+}
+
+// block a PO entry, lines are kept so comments and unknown fields could be written back as they are
+type block struct {
+	lines    []string
+	msgid    string
+	msgstr   string
+	hasMsgid bool
+}
+
+// parseBlocks parse PO content line by line, an entry ends at a blank line, or when a comment or msgid starts after its msgid, so entries without blank lines between them won't be merged
+func parseBlocks(content string) (blocks []*block) {
+	var (
+		b     *block
+		field string
+	)
+
+	for _, line := range strings.Split(strings.Replace(content, "\r\n", "\n", -1), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			b = nil
+			continue
+		}
+
+		if b == nil || (b.hasMsgid && (strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "msgid ") || strings.HasPrefix(trimmed, "msgctxt "))) {
+			b, field = &block{}, ""
+			blocks = append(blocks, b)
+		}
+		b.lines = append(b.lines, line)
+
+		switch {
+		case strings.HasPrefix(trimmed, "#"):
+			continue
+		case strings.HasPrefix(trimmed, `"`):
+			if field == "msgid" {
+				b.msgid += unquote(trimmed)
+			} else if field == "msgstr" {
+				b.msgstr += unquote(trimmed)
+			}
+		default:
+			var value string
+			if idx := strings.Index(trimmed, " "); idx > 0 {
+				field, value = trimmed[:idx], trimmed[idx+1:]
+			}
+
+			switch field {
+			case "msgid":
+				b.msgid, b.hasMsgid = unquote(value), true
+			case "msgstr", "msgstr[0]":
+				field = "msgstr"
+				b.msgstr = unquote(value)
+			}
+		}
+	}
+	return blocks
+}
+
+func unquote(str string) string {
+	if value, err := strconv.Unquote(strings.TrimSpace(str)); err == nil {
+		return value
+	}
+	return ""
+}
+
+func quote(str string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`, "\r", `\r`).Replace(str) + `"`
+}
+
+// setMsgstr replace msgstr lines of block with value
+func (b *block) setMsgstr(value string) {
+	var (
+		lines     []string
+		inMsgstr  bool
+		hasMsgstr bool
+	)
+
+	for _, line := range b.lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "msgstr ") || strings.HasPrefix(trimmed, "msgstr[0] ") {
+			lines = append(lines, trimmed[:strings.Index(trimmed, " ")]+" "+quote(value))
+			inMsgstr, hasMsgstr = true, true
+			continue
+		}
+
+		if inMsgstr && strings.HasPrefix(trimmed, `"`) {
+			continue
+		}
+		inMsgstr = false
+		lines = append(lines, line)
+	}
+
+	if !hasMsgstr {
+		lines = append(lines, "msgstr "+quote(value))
+	}
+	b.lines, b.msgstr = lines, value
+}
+
+func (backend *Backend) files() map[string]string {
+	files := map[string]string{}
+	matches, _ := filepath.Glob(filepath.Join(backend.Dir, "*.po"))
+	for _, match := range matches {
+		files[strings.TrimSuffix(filepath.Base(match), ".po")] = match
+	}
+	return files
+}
+
+// Ping check directory of PO backend exists
+func (backend *Backend) Ping() error {
+	_, err := os.Stat(backend.Dir)
+	return err
+}
+
+// LoadTranslations load translations from PO files, locale is derived from filename
+func (backend *Backend) LoadTranslations() (translations []*i18n.Translation) {
+	for locale, file := range backend.files() {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			continue
+		}
+
+		for _, b := range parseBlocks(string(content)) {
+			// skip header entry
+			if b.hasMsgid && b.msgid != "" {
+				translations = append(translations, &i18n.Translation{Locale: locale, Key: b.msgid, Value: b.msgstr})
+			}
+		}
+	}
+	return translations
+}
+
+func (backend *Backend) update(t *i18n.Translation, fc func([]*block) []*block) error {
+	if t.Locale == "" || strings.ContainsAny(t.Locale, `/\`) || strings.Contains(t.Locale, "..") {
+		return errors.New("invalid locale for PO backend")
+	}
+
+	file := filepath.Join(backend.Dir, t.Locale+".po")
+	content, err := ioutil.ReadFile(file)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	var texts []string
+	for _, b := range fc(parseBlocks(string(content))) {
+		texts = append(texts, strings.Join(b.lines, "\n"))
+	}
+	return ioutil.WriteFile(file, []byte(strings.Join(texts, "\n\n")+"\n"), 0644)
+}
+
+// SaveTranslation save translation into PO file of its locale, comments and order of existing entries are preserved
+func (backend *Backend) SaveTranslation(t *i18n.Translation) error {
+	if t.Key == "" {
+		return errors.New("blank key can't be saved into PO backend")
+	}
+
+	return backend.update(t, func(blocks []*block) []*block {
+		for _, b := range blocks {
+			if b.hasMsgid && b.msgid == t.Key {
+				b.setMsgstr(t.Value)
+				return blocks
+			}
+		}
+
+		return append(blocks, &block{lines: []string{"msgid " + quote(t.Key), "msgstr " + quote(t.Value)}, msgid: t.Key, msgstr: t.Value, hasMsgid: true})
+	})
+}
+
+// DeleteTranslation delete translation from PO file of its locale
+func (backend *Backend) DeleteTranslation(t *i18n.Translation) error {
+	return backend.update(t, func(blocks []*block) (results []*block) {
+		for _, b := range blocks {
+			if !b.hasMsgid || b.msgid != t.Key {
+				results = append(results, b)
+			}
+		}
+		return results
+	})
+}
@@ -0,0 +1,96 @@
+package po_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/qor/i18n"
+	"github.com/qor/i18n/backends/po"
+)
+
+const content = `msgid ""
+msgstr ""
+"Language: zh-CN\n"
+
+# greeting on home page
+#: views/home.tmpl:10
+msgid "home.hello"
+msgstr "你好"
+
+msgid "home.bye"
+msgstr ""
+"再"
+"见"
+`
+
+func loadValues(backend *po.Backend) map[string]string {
+	values := map[string]string{}
+	for _, translation := range backend.LoadTranslations() {
+		values[translation.Locale+":"+translation.Key] = translation.Value
+	}
+	return values
+}
+
+func TestLoadTranslations(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "po")
+	defer os.RemoveAll(dir)
+	ioutil.WriteFile(filepath.Join(dir, "zh-CN.po"), []byte(content), 0644)
+
+	values := loadValues(po.New(dir))
+	if len(values) != 2 || values["zh-CN:home.hello"] != "你好" || values["zh-CN:home.bye"] != "再见" {
+		t.Errorf("failed to load translations from PO file, got %v", values)
+	}
+}
+
+func TestLoadTranslationsWithoutBlankLines(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "po")
+	defer os.RemoveAll(dir)
+	ioutil.WriteFile(filepath.Join(dir, "zh-CN.po"), []byte("msgid \"home.hello\"\nmsgstr \"你好\"\n# farewell\nmsgid \"home.bye\"\nmsgstr \"\"\n\"再见\"\nmsgid \"home.title\"\nmsgstr \"首页\"\n"), 0644)
+
+	values := loadValues(po.New(dir))
+	if len(values) != 3 || values["zh-CN:home.hello"] != "你好" || values["zh-CN:home.bye"] != "再见" || values["zh-CN:home.title"] != "首页" {
+		t.Errorf("entries without blank lines between them shouldn't be merged, got %v", values)
+	}
+}
+
+func TestSaveAndDeleteTranslation(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "po")
+	defer os.RemoveAll(dir)
+	ioutil.WriteFile(filepath.Join(dir, "zh-CN.po"), []byte(content), 0644)
+
+	backend := po.New(dir)
+	backend.SaveTranslation(&i18n.Translation{Locale: "zh-CN", Key: "home.bye", Value: "拜拜"})
+	backend.SaveTranslation(&i18n.Translation{Locale: "zh-CN", Key: "home.title", Value: "首页 \"QOR\""})
+	backend.SaveTranslation(&i18n.Translation{Locale: "en-US", Key: "home.title", Value: "Home"})
+
+	expected := `msgid ""
+msgstr ""
+"Language: zh-CN\n"
+
+# greeting on home page
+#: views/home.tmpl:10
+msgid "home.hello"
+msgstr "你好"
+
+msgid "home.bye"
+msgstr "拜拜"
+
+msgid "home.title"
+msgstr "首页 \"QOR\""
+`
+	if result, _ := ioutil.ReadFile(filepath.Join(dir, "zh-CN.po")); string(result) != expected {
+		t.Errorf("PO file should keep comments and order, but got\n%v", string(result))
+	}
+
+	values := loadValues(backend)
+	if values["zh-CN:home.title"] != "首页 \"QOR\"" || values["en-US:home.title"] != "Home" {
+		t.Errorf("saved translations should be loaded, but got %v", values)
+	}
+
+	backend.DeleteTranslation(&i18n.Translation{Locale: "zh-CN", Key: "home.hello"})
+	if values := loadValues(backend); values["zh-CN:home.hello"] != "" || values["zh-CN:home.bye"] != "拜拜" {
+		t.Errorf("translation should be deleted, but got %v", values)
+	}
+}
@@ -0,0 +1,172 @@
+package sheets
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/qor/i18n"
+)
+
+var _ i18n.Backend = &Backend{}
+
+// New new Google Sheets backend for I18n, it loads translations from the published CSV export of a sheet, e.g: `https://docs.google.com/spreadsheets/d/<id>/export?format=csv`
+// The first row of the sheet is the header, the first column is key and other columns are locales, e.g: `key,en-US,zh-CN`
+// The sheet is fetched again when loading translations if it was fetched before refresh duration
+func New(csvExportURL string, refresh time.Duration) *Backend {
+	return &Backend{URL: csvExportURL, Refresh: refresh, Client: http.DefaultClient}
+}
+
+// Backend Google Sheets backend
+type Backend struct {
+	URL     string
+	Refresh time.Duration
+	Client  *http.Client
+
+	mutex        sync.Mutex
+	translations []*i18n.Translation
+	fetchedAt    time.Time
+}
+
+// LoadTranslations load translations from Google Sheets backend
+func (backend *Backend) LoadTranslations() []*i18n.Translation {
+	translations, _ := backend.LoadTranslationsE()
+	return translations
+}
+
+// LoadTranslationsE load translations from Google Sheets backend, translations fetched last time will be returned with the error if failed to fetch the sheet
+func (backend *Backend) LoadTranslationsE() ([]*i18n.Translation, error) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+
+	if !backend.fetchedAt.IsZero() && time.Since(backend.fetchedAt) < backend.Refresh {
+		return backend.translations, nil
+	}
+
+	translations, err := backend.fetch()
+	if err != nil {
+		return backend.translations, err
+	}
+
+	backend.translations, backend.fetchedAt = translations, time.Now()
+	return translations, nil
+}
+
+// AutoReload reload translations of I18n every refresh duration, so changes of the sheet will be applied without restart, call the returned func to stop
+// It does nothing if refresh duration isn't positive, the sheet is fetched every time translations are loaded in that case
+func (backend *Backend) AutoReload(I18n *i18n.I18n) (stop func()) {
+	if backend.Refresh <= 0 {
+		return func() {}
+	}
+
+	var (
+		ticker = time.NewTicker(backend.Refresh)
+		done   = make(chan struct{})
+		once   sync.Once
+	)
+
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				I18n.Reload()
+			case <-done:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+
+	return func() { once.Do(func() { close(done) }) }
+}
+
+func (backend *Backend) fetch() ([]*i18n.Translation, error) {
+	client := backend.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Get(backend.URL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch sheet, got status %v", resp.Status)
+	}
+
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return parseCSV(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf")))
+}
+
+// parseCSV parse CSV export of the sheet, blank cells and columns without locale are skipped
+func parseCSV(content []byte) ([]*i18n.Translation, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 || len(records[0]) < 2 {
+		return nil, errors.New("sheet should have a header row like `key,en-US`")
+	}
+
+	var translations []*i18n.Translation
+	for _, record := range records[1:] {
+		key := strings.TrimSpace(record[0])
+		if key == "" {
+			continue
+		}
+
+		for idx, value := range record[1:] {
+			if idx+1 >= len(records[0]) {
+				break
+			}
+
+			locale := strings.TrimSpace(records[0][idx+1])
+			if locale == "" || value == "" {
+				continue
+			}
+			translations = append(translations, &i18n.Translation{Key: key, Locale: locale, Value: value})
+		}
+	}
+	return translations, nil
+}
+
+// Ping check the sheet could be fetched
+func (backend *Backend) Ping() error {
+	_, err := backend.fetch()
+	return err
+}
+
+// ReadOnly Google Sheets backend is read-only, translations need to be edited in the sheet
+func (backend *Backend) ReadOnly() bool {
+	return true
+}
+
+// BackendName return name of Google Sheets backend
+func (backend *Backend) BackendName() string {
+	return "Google Sheets"
+}
+
+// SaveTranslation save translation into Google Sheets backend, not supported
+func (backend *Backend) SaveTranslation(t *i18n.Translation) error {
+	return errors.New("translations can't be saved into Google Sheets backend")
+}
+
+// DeleteTranslation delete translation from Google Sheets backend, not supported
+func (backend *Backend) DeleteTranslation(t *i18n.Translation) error {
+	return errors.New("translations can't be deleted from Google Sheets backend")
+}
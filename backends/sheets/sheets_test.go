@@ -0,0 +1,95 @@
+package sheets_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/qor/i18n"
+	"github.com/qor/i18n/backends/sheets"
+)
+
+func TestLoadTranslations(t *testing.T) {
+	var requests int32
+	content := "\xef\xbb\xbfkey,en-US,zh-CN,\n" +
+		"home.title,Home,首页,note\n" +
+		"home.welcome,\"Welcome, {{$1}}\",\n" +
+		",ignored,ignored\n"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "text/csv")
+		w.Write([]byte(content))
+	}))
+	defer server.Close()
+
+	I18n := i18n.New(sheets.New(server.URL, time.Hour))
+
+	for _, c := range []struct{ locale, key, expected string }{
+		{"en-US", "home.title", "Home"},
+		{"zh-CN", "home.title", "首页"},
+		{"en-US", "home.welcome", "Welcome, Jinzhu"},
+		{"zh-CN", "home.welcome", "Welcome, Jinzhu"},
+	} {
+		if result := I18n.T(c.locale, c.key, "Jinzhu"); string(result) != c.expected {
+			t.Errorf("%v of %v should be %v, but got %v", c.key, c.locale, c.expected, result)
+		}
+	}
+
+	if count := len(I18n.LoadTranslations()["zh-CN"]); count != 1 {
+		t.Errorf("blank cells should be skipped, but got %v translations", count)
+	}
+
+	if count := atomic.LoadInt32(&requests); count != 1 {
+		t.Errorf("sheet should be fetched again only after refresh duration, but fetched %v times", count)
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	var value atomic.Value
+	value.Store("Home")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte("key,en-US\nhome.title," + value.Load().(string) + "\n"))
+	}))
+	defer server.Close()
+
+	backend := sheets.New(server.URL, 0)
+	I18n := i18n.New(backend)
+	value.Store("Homepage")
+
+	if err := I18n.Reload(); err != nil {
+		t.Fatalf("failed to reload, got %v", err)
+	}
+
+	if result := I18n.T("en-US", "home.title"); result != "Homepage" {
+		t.Errorf("sheet should be fetched again after refresh duration, but got %v", result)
+	}
+
+	server.Close()
+	if _, err := backend.LoadTranslationsE(); err == nil {
+		t.Errorf("should return error if failed to fetch the sheet")
+	}
+
+	if translations := backend.LoadTranslations(); len(translations) != 1 || translations[0].Value != "Homepage" {
+		t.Errorf("should return translations fetched last time if failed to fetch, but got %v", translations)
+	}
+
+	if err := backend.Ping(); err == nil {
+		t.Errorf("ping should fail if the sheet couldn't be fetched")
+	}
+}
+
+func TestAutoReloadWithoutRefresh(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte("key,en-US\nhome.title,Home\n"))
+	}))
+	defer server.Close()
+
+	backend := sheets.New(server.URL, 0)
+	// shouldn't panic like time.NewTicker with non-positive duration
+	stop := backend.AutoReload(i18n.New(backend))
+	stop()
+	stop()
+}
@@ -17,7 +17,7 @@ var _ i18n.Backend = &Backend{}
 
 // New new YAML backend for I18n
 func New(paths ...string) *Backend {
-	backend := &Backend{}
+	backend := &Backend{paths: paths}
 
 	var files []string
 	for _, p := range paths {
@@ -46,7 +46,7 @@ func New(paths ...string) *Backend {
 
 // NewWithWalk has the same functionality as New but uses filepath.Walk to find all the translation files recursively.
 func NewWithWalk(paths ...string) i18n.Backend {
-	backend := &Backend{}
+	backend := &Backend{paths: paths}
 
 	var files []string
 	for _, p := range paths {
@@ -122,9 +122,20 @@ func NewWithFilesystem(fss ...http.FileSystem) i18n.Backend {
 
 // Backend YAML backend
 type Backend struct {
+	paths    []string
 	contents [][]byte
 }
 
+// Ping check translation paths of YAML backend exist
+func (backend *Backend) Ping() error {
+	for _, p := range backend.paths {
+		if _, err := os.Stat(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func loadTranslationsFromYaml(locale string, value interface{}, scopes []string) (translations []*i18n.Translation) {
 	switch v := value.(type) {
 	case yaml.MapSlice:
@@ -168,6 +179,16 @@ func (backend *Backend) LoadTranslations() (translations []*i18n.Translation) {
 	return translations
 }
 
+// ReadOnly YAML backend is read-only, translations can't be saved into it
+func (backend *Backend) ReadOnly() bool {
+	return true
+}
+
+// BackendName return name of YAML backend
+func (backend *Backend) BackendName() string {
+	return "YAML"
+}
+
 // SaveTranslation save translation into YAML backend, not implemented
 func (backend *Backend) SaveTranslation(t *i18n.Translation) error {
 	return errors.New("not implemented")
@@ -106,3 +106,19 @@ func BenchmarkLoadTranslationsFilesystem(b *testing.B) {
 	}
 	benchmarkResult3 = err
 }
+
+func TestPing(t *testing.T) {
+	if err := yaml.New("tests", "tests/subdir").Ping(); err != nil {
+		t.Errorf("existing paths should pass ping, but got %v", err)
+	}
+
+	if err := yaml.New("tests", "tests/not_exist").Ping(); err == nil {
+		t.Errorf("should return error if path doesn't exist")
+	}
+}
+
+func TestReadOnly(t *testing.T) {
+	if !yaml.New("tests").ReadOnly() {
+		t.Errorf("YAML backend should be read-only")
+	}
+}
@@ -0,0 +1,53 @@
+package i18n
+
+import "fmt"
+
+// TransactionalBackend is an optional interface for backends that could save multiple translations in one transaction
+// SaveTranslations should save all translations or none of them
+type TransactionalBackend interface {
+	SaveTranslations([]*Translation) error
+}
+
+// BatchSave save translations, if the first backend supports transaction, all translations will be saved in one transaction and rolled back on error
+// Otherwise translations will be saved one by one like `SaveTranslation`
+func (i18n *I18n) BatchSave(translations []*Translation) error {
+	if len(i18n.Backends) > 0 {
+		if backend, ok := i18n.Backends[0].(TransactionalBackend); ok {
+			for _, translation := range translations {
+				if err := i18n.prepareTranslation(translation); err != nil {
+					return fmt.Errorf("failed to save translation %v of %v, got: %v", translation.Key, translation.Locale, err)
+				}
+			}
+
+			if err := i18n.retry(func() error { return backend.SaveTranslations(translations) }); err != nil {
+				return err
+			}
+
+			for _, translation := range translations {
+				i18n.AddTranslation(translation)
+			}
+			return nil
+		}
+	}
+
+	for _, translation := range translations {
+		if err := i18n.SaveTranslation(translation); err != nil {
+			return fmt.Errorf("failed to save translation %v of %v, got: %v", translation.Key, translation.Locale, err)
+		}
+	}
+	return nil
+}
+
+// SetAll save the same value of key for locales, it is useful for content that is identical in all locales like brand names
+// The value will be saved for all locales that have translations if no locale given
+func (i18n *I18n) SetAll(key, value string, locales ...string) error {
+	if len(locales) == 0 {
+		locales = i18n.availableLocales()
+	}
+
+	var translations []*Translation
+	for _, locale := range locales {
+		translations = append(translations, &Translation{Key: key, Locale: locale, Value: value})
+	}
+	return i18n.BatchSave(translations)
+}
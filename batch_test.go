@@ -0,0 +1,98 @@
+package i18n
+
+import (
+	"errors"
+	"testing"
+)
+
+type transactionalBackend struct {
+	mapBackend
+	transactions int
+}
+
+func (b *transactionalBackend) SaveTranslations(translations []*Translation) error {
+	b.transactions++
+	for _, translation := range translations {
+		if translation.Value == "" {
+			// rollback
+			return errors.New("blank value")
+		}
+	}
+
+	for _, translation := range translations {
+		b.mapBackend.SaveTranslation(translation)
+	}
+	return nil
+}
+
+func (b *transactionalBackend) SaveTranslation(t *Translation) error {
+	return errors.New("should save translations in transaction")
+}
+
+func TestBatchSave(t *testing.T) {
+	backend := &transactionalBackend{mapBackend: mapBackend{translations: map[string]*Translation{}}}
+	i18n := New(backend)
+
+	err := i18n.BatchSave([]*Translation{
+		{Key: "hello", Locale: "en-US", Value: "Hello"},
+		{Key: "bye", Locale: "en-US", Value: "Bye"},
+	})
+
+	if err != nil || backend.transactions != 1 || len(backend.translations) != 2 {
+		t.Fatalf("should save translations in one transaction, but got %v, %v transactions", err, backend.transactions)
+	}
+
+	if result := i18n.T("en-US", "bye"); result != "Bye" {
+		t.Errorf("saved translations should be cached, but got %v", result)
+	}
+
+	err = i18n.BatchSave([]*Translation{
+		{Key: "title", Locale: "en-US", Value: "Title"},
+		{Key: "blank", Locale: "en-US", Value: ""},
+	})
+
+	if err == nil || len(backend.translations) != 2 {
+		t.Errorf("should roll back all translations on error")
+	}
+
+	if _, found := i18n.findTranslation("en-US", "title"); found {
+		t.Errorf("rolled back translations shouldn't be cached")
+	}
+}
+
+func TestBatchSaveWithoutTransaction(t *testing.T) {
+	backend := &mapBackend{translations: map[string]*Translation{}}
+	i18n := New(backend)
+
+	if err := i18n.BatchSave([]*Translation{{Key: "hello", Locale: "en-US", Value: "Hello"}, {Key: "bye", Locale: "en-US", Value: "Bye"}}); err != nil || len(backend.translations) != 2 {
+		t.Errorf("should save translations one by one, but got %v", err)
+	}
+}
+
+func TestSetAll(t *testing.T) {
+	i18n := New(&sliceBackend{translations: []*Translation{
+		{Key: "hello", Locale: "en-US", Value: "Hello"},
+		{Key: "hello", Locale: "zh-CN", Value: "你好"},
+		{Key: "hello", Locale: "de-DE", Value: "Hallo"},
+	}})
+
+	if err := i18n.SetAll("brand", "QOR", "en-US", "ja-JP"); err != nil {
+		t.Fatalf("failed to set value for locales, got %v", err)
+	}
+
+	for locale, expected := range map[string]bool{"en-US": true, "ja-JP": true, "zh-CN": false} {
+		if value, _ := i18n.Raw(locale, "brand"); (value == "QOR") != expected {
+			t.Errorf("brand of %v should be set: %v, but got %v", locale, expected, value)
+		}
+	}
+
+	if err := i18n.SetAll("code", "ISO-639"); err != nil {
+		t.Fatalf("failed to set value for all locales, got %v", err)
+	}
+
+	for _, locale := range []string{"en-US", "zh-CN", "de-DE", "ja-JP"} {
+		if result := i18n.T(locale, "code"); result != "ISO-639" {
+			t.Errorf("code of %v should be set, but got %v", locale, result)
+		}
+	}
+}
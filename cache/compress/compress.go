@@ -0,0 +1,121 @@
+package compress
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+
+	"github.com/qor/cache"
+	"github.com/qor/i18n/internal/cacheutil"
+)
+
+var _ cache.CacheStoreInterface = &Store{}
+
+// DefaultThreshold values equal or larger than the size in bytes will be compressed by default
+var DefaultThreshold = 1024
+
+// compressedPrefix prefix of compressed values, values start with it are always compressed, so they won't be mistaken for compressed ones
+const compressedPrefix = "\x00gzip:"
+
+// Wrap wrap cache store, values equal or larger than Threshold are gzipped on set and decompressed on get transparently
+func Wrap(store cache.CacheStoreInterface) *Store {
+	return &Store{store: store, Threshold: DefaultThreshold}
+}
+
+// Store cache store compresses large values
+type Store struct {
+	store     cache.CacheStoreInterface
+	Threshold int
+}
+
+// Get get value of key, it is decompressed if it was compressed
+func (store *Store) Get(key string) (string, error) {
+	value, err := store.store.Get(key)
+	if err != nil || !strings.HasPrefix(value, compressedPrefix) {
+		return value, err
+	}
+	return decompress(strings.TrimPrefix(value, compressedPrefix))
+}
+
+// Unmarshal get value of key and unmarshal it into object
+func (store *Store) Unmarshal(key string, object interface{}) error {
+	value, err := store.Get(key)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal([]byte(value), object)
+}
+
+// Set set value of key, it is compressed if it is larger than Threshold
+func (store *Store) Set(key string, value interface{}) error {
+	str, err := cacheutil.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	if len(str) >= store.Threshold || strings.HasPrefix(str, compressedPrefix) {
+		if str, err = compress(str); err != nil {
+			return err
+		}
+		str = compressedPrefix + str
+	}
+	return store.store.Set(key, str)
+}
+
+// Fetch get value of key, set it with result of fc if it doesn't exist
+func (store *Store) Fetch(key string, fc func() interface{}) (string, error) {
+	if value, err := store.Get(key); err == nil {
+		return value, nil
+	}
+
+	if err := store.Set(key, fc()); err != nil {
+		return "", err
+	}
+	return store.Get(key)
+}
+
+// Delete delete key from the wrapped store
+func (store *Store) Delete(key string) error {
+	return store.store.Delete(key)
+}
+
+// HasEvicted return true if the wrapped store has evicted entries, it is always false if the wrapped store doesn't evict entries
+func (store *Store) HasEvicted() bool {
+	if evicting, ok := store.store.(interface {
+		HasEvicted() bool
+	}); ok {
+		return evicting.HasEvicted()
+	}
+	return false
+}
+
+func compress(value string) (string, error) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write([]byte(value)); err != nil {
+		return "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+func decompress(value string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", err
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	result, err := ioutil.ReadAll(reader)
+	return string(result), err
+}
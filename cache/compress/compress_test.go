@@ -0,0 +1,60 @@
+package compress_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qor/cache/memory"
+	"github.com/qor/i18n"
+	"github.com/qor/i18n/cache/compress"
+	"github.com/qor/i18n/cache/lru"
+)
+
+func TestRoundTripLargeValues(t *testing.T) {
+	backend := memory.New()
+	store := compress.Wrap(backend)
+
+	large := strings.Repeat("Terms and conditions apply. ", 200)
+	if err := store.Set("large", large); err != nil {
+		t.Fatalf("failed to set large value, got %v", err)
+	}
+
+	raw, _ := backend.Get("large")
+	if len(raw) >= len(large) || strings.Contains(raw, "Terms") {
+		t.Errorf("large value should be compressed in wrapped store, got %v bytes", len(raw))
+	}
+
+	if value, err := store.Get("large"); err != nil || value != large {
+		t.Errorf("large value should be decompressed, got %v", err)
+	}
+
+	store.Set("small", "hello")
+	if raw, _ := backend.Get("small"); raw != "hello" {
+		t.Errorf("small value shouldn't be compressed, got %v", raw)
+	}
+
+	store.Set("struct", map[string]string{"Value": large})
+	var result map[string]string
+	if err := store.Unmarshal("struct", &result); err != nil || result["Value"] != large {
+		t.Errorf("failed to unmarshal compressed value, got %v", err)
+	}
+
+	if err := store.Set("prefixed", "\x00gzip:hello"); err != nil {
+		t.Fatal(err)
+	}
+	if value, _ := store.Get("prefixed"); value != "\x00gzip:hello" {
+		t.Errorf("value looks like compressed one should round trip, got %q", value)
+	}
+}
+
+func TestWrapI18nCacheStore(t *testing.T) {
+	large := strings.Repeat("Long legal text. ", 200)
+
+	I18n := i18n.New()
+	I18n.SetCacheStore(compress.Wrap(lru.New(10)))
+	I18n.AddTranslation(&i18n.Translation{Locale: "en-US", Key: "legal.terms", Value: large})
+
+	if value := I18n.T("en-US", "legal.terms"); string(value) != large {
+		t.Errorf("should translate with compressed value, got %v", value)
+	}
+}
@@ -0,0 +1,121 @@
+package lru
+
+import (
+	"container/list"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/qor/cache"
+	"github.com/qor/i18n/internal/cacheutil"
+)
+
+var _ cache.CacheStoreInterface = &Store{}
+
+// ErrNotFound returned when key isn't in the cache store
+var ErrNotFound = errors.New("not found")
+
+// New new in-memory cache store holds at most max entries, the least recently used entry is evicted when it is full
+// Entries evicted from I18n's cache store will be reloaded from backends on next access
+func New(max int) *Store {
+	return &Store{max: max, items: map[string]*list.Element{}, order: list.New()}
+}
+
+// Store LRU cache store
+type Store struct {
+	max     int
+	mutex   sync.Mutex
+	items   map[string]*list.Element
+	order   *list.List
+	evicted bool
+}
+
+type entry struct {
+	key   string
+	value string
+}
+
+// Get get value of key, it marks the key as recently used
+func (store *Store) Get(key string) (string, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	if elem, ok := store.items[key]; ok {
+		store.order.MoveToFront(elem)
+		return elem.Value.(*entry).value, nil
+	}
+	return "", ErrNotFound
+}
+
+// Unmarshal get value of key and unmarshal it into object
+func (store *Store) Unmarshal(key string, object interface{}) error {
+	value, err := store.Get(key)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal([]byte(value), object)
+}
+
+// Set set value of key, the least recently used entry will be evicted if the store is full
+func (store *Store) Set(key string, value interface{}) error {
+	str, err := cacheutil.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	if elem, ok := store.items[key]; ok {
+		elem.Value.(*entry).value = str
+		store.order.MoveToFront(elem)
+		return nil
+	}
+
+	store.items[key] = store.order.PushFront(&entry{key: key, value: str})
+	for store.max > 0 && store.order.Len() > store.max {
+		oldest := store.order.Back()
+		store.order.Remove(oldest)
+		delete(store.items, oldest.Value.(*entry).key)
+		store.evicted = true
+	}
+	return nil
+}
+
+// Fetch get value of key, set it with result of fc if it doesn't exist
+func (store *Store) Fetch(key string, fc func() interface{}) (string, error) {
+	if value, err := store.Get(key); err == nil {
+		return value, nil
+	}
+
+	if err := store.Set(key, fc()); err != nil {
+		return "", err
+	}
+	return store.Get(key)
+}
+
+// Delete delete key from the store
+func (store *Store) Delete(key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	if elem, ok := store.items[key]; ok {
+		store.order.Remove(elem)
+		delete(store.items, key)
+	}
+	return nil
+}
+
+// Len return count of entries in the store
+func (store *Store) Len() int {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	return store.order.Len()
+}
+
+// HasEvicted return true if any entry has been evicted because the store is full
+func (store *Store) HasEvicted() bool {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	return store.evicted
+}
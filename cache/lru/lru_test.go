@@ -0,0 +1,59 @@
+package lru_test
+
+import (
+	"testing"
+
+	"github.com/qor/i18n/cache/lru"
+)
+
+func TestEvictLeastRecentlyUsed(t *testing.T) {
+	store := lru.New(2)
+	store.Set("a", "1")
+	store.Set("b", "2")
+	store.Get("a")
+	store.Set("c", "3")
+
+	if store.Len() != 2 {
+		t.Errorf("store should hold at most 2 entries, but got %v", store.Len())
+	}
+
+	if _, err := store.Get("b"); err != lru.ErrNotFound {
+		t.Errorf("least recently used entry should be evicted, got %v", err)
+	}
+	if !store.HasEvicted() {
+		t.Errorf("store should report it has evicted entries")
+	}
+
+	for key, value := range map[string]string{"a": "1", "c": "3"} {
+		if v, err := store.Get(key); err != nil || v != value {
+			t.Errorf("%v should be kept, got %v, %v", key, v, err)
+		}
+	}
+
+	store.Delete("a")
+	if _, err := store.Get("a"); err == nil {
+		t.Errorf("deleted entry shouldn't be found")
+	}
+
+	if lru.New(2).HasEvicted() {
+		t.Errorf("new store shouldn't report evicted entries")
+	}
+}
+
+func TestUnmarshalAndFetch(t *testing.T) {
+	store := lru.New(10)
+	store.Set("struct", map[string]string{"Value": "hello"})
+
+	var result map[string]string
+	if err := store.Unmarshal("struct", &result); err != nil || result["Value"] != "hello" {
+		t.Errorf("failed to unmarshal value, got %v, %v", result, err)
+	}
+
+	value, err := store.Fetch("fetch", func() interface{} { return "fetched" })
+	if err != nil || value != "fetched" {
+		t.Errorf("failed to fetch value, got %v, %v", value, err)
+	}
+	if value, _ := store.Fetch("fetch", func() interface{} { return "again" }); value != "fetched" {
+		t.Errorf("fetch should return existing value, got %v", value)
+	}
+}
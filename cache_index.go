@@ -0,0 +1,90 @@
+package i18n
+
+import (
+	"sort"
+	"sync"
+)
+
+// cacheIndex keys of translations in cache store by locale, cache stores can't be iterated, so the index is used to list cached translations
+type cacheIndex struct {
+	mutex sync.RWMutex
+	keys  map[string]map[string]bool
+}
+
+func (index *cacheIndex) add(locale, key string) {
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+
+	if index.keys[locale] == nil {
+		index.keys[locale] = map[string]bool{}
+	}
+	index.keys[locale][key] = true
+}
+
+func (index *cacheIndex) remove(locale, key string) {
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+	delete(index.keys[locale], key)
+}
+
+func (index *cacheIndex) reset() {
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+	index.keys = map[string]map[string]bool{}
+}
+
+// keysOf return sorted keys of locale
+func (index *cacheIndex) keysOf(locale string) []string {
+	index.mutex.RLock()
+	defer index.mutex.RUnlock()
+
+	var keys []string
+	for key := range index.keys[locale] {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// locales return sorted locales that have cached translations
+func (index *cacheIndex) locales() []string {
+	index.mutex.RLock()
+	defer index.mutex.RUnlock()
+
+	var locales []string
+	for locale, keys := range index.keys {
+		if len(keys) > 0 {
+			locales = append(locales, locale)
+		}
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// deleteCached delete translation of locale and key from cache store
+func (i18n *I18n) deleteCached(locale, key string) error {
+	i18n.cached.remove(locale, key)
+	return i18n.cacheStore.Delete(i18n.cacheKeyFor(locale, key))
+}
+
+// cachedTranslations return translations in cache store ordered by locale then key, including ones only added to cache store like translations added with `AddTranslation`
+// Translations evicted from cache stores like `lru.New(max)` aren't included
+func (i18n *I18n) cachedTranslations() []*Translation {
+	var translations []*Translation
+	for _, locale := range i18n.cached.locales() {
+		translations = append(translations, i18n.cachedTranslationsOf(locale)...)
+	}
+	return translations
+}
+
+// cachedTranslationsOf return translations of locale in cache store ordered by key
+func (i18n *I18n) cachedTranslationsOf(locale string) []*Translation {
+	var translations []*Translation
+	for _, key := range i18n.cached.keysOf(locale) {
+		var translation Translation
+		if err := i18n.cacheStore.Unmarshal(i18n.cacheKeyFor(locale, key), &translation); err == nil {
+			translations = append(translations, &translation)
+		}
+	}
+	return translations
+}
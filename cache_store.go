@@ -0,0 +1,51 @@
+package i18n
+
+// EvictingCacheStore is an optional interface for cache stores that evict entries, like `lru.New(max)`
+// Once a cache store has evicted entries, translations missing from it will be reloaded from backends on access
+type EvictingCacheStore interface {
+	HasEvicted() bool
+}
+
+// TranslationLoader is an optional interface for backends that could load a single translation, it returns nil if there is no translation for locale and key
+// Backends used with evicting cache stores should implement it, otherwise all translations of the backend are loaded to reload an evicted one
+type TranslationLoader interface {
+	LoadTranslation(locale, key string) (*Translation, error)
+}
+
+// reloadEvicted reload translation of locale and key from backends if cache store has evicted entries
+func (i18n *I18n) reloadEvicted(locale, key string) (Translation, bool) {
+	store, ok := i18n.cacheStore.(EvictingCacheStore)
+	if !ok || !store.HasEvicted() {
+		return Translation{}, false
+	}
+
+	for _, backend := range i18n.Backends {
+		if translation := i18n.loadBackendTranslation(backend, locale, key); translation != nil {
+			i18n.AddTranslation(translation)
+			if translation.Value == "" {
+				return Translation{}, false
+			}
+			return *translation, true
+		}
+	}
+	return Translation{}, false
+}
+
+// loadBackendTranslation load translation of locale and key from backend, with `TranslationLoader` if backend implements it
+func (i18n *I18n) loadBackendTranslation(backend Backend, locale, key string) *Translation {
+	if loader, ok := backend.(TranslationLoader); ok {
+		translation, err := loader.LoadTranslation(locale, key)
+		if err != nil {
+			i18n.logf("Failed to load translation %v of %v from backend %T, got: %v", key, locale, backend, err)
+			return nil
+		}
+		return translation
+	}
+
+	for _, translation := range i18n.loadBackendTranslations(backend) {
+		if translation.Locale == locale && i18n.cacheKeyFor(locale, translation.Key) == i18n.cacheKeyFor(locale, key) {
+			return translation
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package i18n
+
+import (
+	"fmt"
+	"html/template"
+	"sync"
+	"testing"
+
+	"github.com/qor/i18n/cache/lru"
+)
+
+type countingBackend struct {
+	sliceBackend
+	loads int
+}
+
+func (b *countingBackend) LoadTranslations() []*Translation {
+	b.loads++
+	return b.sliceBackend.LoadTranslations()
+}
+
+func TestLRUCacheStore(t *testing.T) {
+	backend := &countingBackend{}
+	for i := 0; i < 5; i++ {
+		backend.translations = append(backend.translations, &Translation{Locale: "en-US", Key: fmt.Sprintf("key%v", i), Value: fmt.Sprintf("Value %v", i)})
+	}
+
+	store := lru.New(3)
+	i18n := New(backend)
+	i18n.SetCacheStore(store)
+
+	if store.Len() != 3 {
+		t.Errorf("cache store should hold at most 3 translations, got %v", store.Len())
+	}
+	if !store.HasEvicted() {
+		t.Errorf("translations should be evicted")
+	}
+
+	loads := backend.loads
+	if value := i18n.T("en-US", "key0"); value != "Value 0" {
+		t.Errorf("evicted translation should be reloaded from backends, got %v", value)
+	}
+	if backend.loads != loads+1 {
+		t.Errorf("backend should be loaded once to reload evicted translation, got %v", backend.loads-loads)
+	}
+
+	if value := i18n.T("en-US", "key0"); value != "Value 0" || backend.loads != loads+1 {
+		t.Errorf("reloaded translation should be cached, got %v", value)
+	}
+
+	i18n.T("en-US", "missing")
+	loads = backend.loads
+	if value := i18n.T("en-US", "missing"); value != "missing" || backend.loads != loads {
+		t.Errorf("backends shouldn't be loaded again for auto created translations")
+	}
+}
+
+type loaderBackend struct {
+	countingBackend
+	mutex   sync.Mutex
+	lookups int
+}
+
+func (b *loaderBackend) LoadTranslation(locale, key string) (*Translation, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.lookups++
+	for _, translation := range b.translations {
+		if translation.Locale == locale && translation.Key == key {
+			return translation, nil
+		}
+	}
+	return nil, nil
+}
+
+func TestLRUCacheStoreWithTranslationLoader(t *testing.T) {
+	backend := &loaderBackend{}
+	for i := 0; i < 5; i++ {
+		backend.translations = append(backend.translations, &Translation{Locale: "en-US", Key: fmt.Sprintf("key%v", i), Value: fmt.Sprintf("Value %v", i)})
+	}
+
+	i18n := New(backend)
+	i18n.SetCacheStore(lru.New(3))
+
+	loads := backend.loads
+	for i := 0; i < 5; i++ {
+		if value := i18n.T("en-US", fmt.Sprintf("key%v", i)); value != template.HTML(fmt.Sprintf("Value %v", i)) {
+			t.Errorf("evicted translation should be reloaded from backend, got %v", value)
+		}
+	}
+
+	if backend.loads != loads {
+		t.Errorf("backend shouldn't load all translations to reload evicted ones, got %v loads", backend.loads-loads)
+	}
+	if backend.lookups == 0 {
+		t.Errorf("evicted translations should be loaded one by one")
+	}
+}
@@ -0,0 +1,63 @@
+package i18n
+
+import (
+	"github.com/theplant/cldr"
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+	"golang.org/x/text/message"
+)
+
+// CLDRProvider provides CLDR data used to format translations, it could be replaced to use custom or patched rules without forking
+type CLDRProvider interface {
+	// Parse render CLDR pattern with arguments, plural rules are applied by the `p` function of patterns
+	Parse(locale, value string, args ...interface{}) (string, error)
+	// FormatNumber format number with locale's number format, e.g: `1234567` => `1,234,567` for `en-US`
+	FormatNumber(locale string, number interface{}) string
+	// DisplayName return name of language code in locale, e.g: `zh` => `Chinese` for `en-US`
+	DisplayName(locale, code string) string
+	// OrdinalCategory return CLDR ordinal plural category of n for locale, it is used by `TOrdinal`, e.g: `few` for 3rd in English
+	OrdinalCategory(locale string, n int) string
+}
+
+// DefaultCLDRProvider default CLDR provider, patterns are parsed with `theplant/cldr`, number formats and display names come from CLDR data of `golang.org/x/text`, ordinal categories come from `OrdinalCategory`
+var DefaultCLDRProvider CLDRProvider = defaultCLDRProvider{}
+
+type defaultCLDRProvider struct{}
+
+func (defaultCLDRProvider) Parse(locale, value string, args ...interface{}) (string, error) {
+	return cldr.Parse(locale, value, args...)
+}
+
+func (defaultCLDRProvider) FormatNumber(locale string, number interface{}) string {
+	return message.NewPrinter(language.Make(locale)).Sprint(number)
+}
+
+func (defaultCLDRProvider) DisplayName(locale, code string) string {
+	return display.Tags(language.Make(locale)).Name(language.Make(code))
+}
+
+func (defaultCLDRProvider) OrdinalCategory(locale string, n int) string {
+	return OrdinalCategory(locale, n)
+}
+
+// SetCLDRProvider set CLDR provider used to parse translations and format numbers, `DefaultCLDRProvider` is used if not set
+func (i18n *I18n) SetCLDRProvider(provider CLDRProvider) {
+	i18n.cldrProvider = provider
+}
+
+func (i18n *I18n) getCLDRProvider() CLDRProvider {
+	if i18n.cldrProvider != nil {
+		return i18n.cldrProvider
+	}
+	return DefaultCLDRProvider
+}
+
+// FormatNumber format number with number format of locale
+func (i18n *I18n) FormatNumber(locale string, number interface{}) string {
+	return i18n.getCLDRProvider().FormatNumber(locale, number)
+}
+
+// DisplayName return name of language code in locale
+func (i18n *I18n) DisplayName(locale, code string) string {
+	return i18n.getCLDRProvider().DisplayName(locale, code)
+}
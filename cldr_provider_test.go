@@ -0,0 +1,49 @@
+package i18n
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type swissCLDRProvider struct {
+	CLDRProvider
+}
+
+func (swissCLDRProvider) FormatNumber(locale string, number interface{}) string {
+	str := fmt.Sprint(number)
+	for i := len(str) - 3; i > 0; i -= 3 {
+		str = str[:i] + "'" + str[i:]
+	}
+	return str
+}
+
+func (provider swissCLDRProvider) Parse(locale, value string, args ...interface{}) (string, error) {
+	return provider.CLDRProvider.Parse(locale, strings.Replace(value, "{{number}}", provider.FormatNumber(locale, args[0]), -1))
+}
+
+func TestCLDRProvider(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "total", Locale: "de-CH", Value: "Total: {{number}}"})
+
+	if result := i18n.FormatNumber("en-US", 1234567); result != "1,234,567" {
+		t.Errorf("should format number with default provider, but got %v", result)
+	}
+
+	if result := i18n.DisplayName("en-US", "zh"); result != "Chinese" {
+		t.Errorf("should return display name with default provider, but got %v", result)
+	}
+
+	i18n.SetCLDRProvider(swissCLDRProvider{DefaultCLDRProvider})
+	if result := i18n.FormatNumber("de-CH", 1234567); result != "1'234'567" {
+		t.Errorf("should format number with custom provider, but got %v", result)
+	}
+
+	if result := i18n.T("de-CH", "total", 1234567); result != "Total: 1'234'567" {
+		t.Errorf("should parse translation with custom provider, but got %v", result)
+	}
+
+	if result := i18n.DisplayName("en-US", "de"); result != "German" {
+		t.Errorf("should fall back to embedded provider, but got %v", result)
+	}
+}
@@ -0,0 +1,11 @@
+package i18n
+
+import (
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// SortStrings sort strings in place with collation rules of locale, e.g: `å`, `ä`, `ö` are sorted after `z` for `sv-SE`
+func SortStrings(locale string, s []string) {
+	collate.New(language.Make(locale)).SortStrings(s)
+}
@@ -0,0 +1,22 @@
+package i18n
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSortStrings(t *testing.T) {
+	words := []string{"ört", "zebra", "äpple", "apa", "åka"}
+
+	enWords := append([]string{}, words...)
+	SortStrings("en-US", enWords)
+	if !reflect.DeepEqual(enWords, []string{"åka", "apa", "äpple", "ört", "zebra"}) {
+		t.Errorf("accented characters should be sorted with their base letters for en-US, but got %v", enWords)
+	}
+
+	svWords := append([]string{}, words...)
+	SortStrings("sv-SE", svWords)
+	if !reflect.DeepEqual(svWords, []string{"apa", "zebra", "åka", "äpple", "ört"}) {
+		t.Errorf("å, ä, ö should be sorted after z for sv-SE, but got %v", svWords)
+	}
+}
@@ -0,0 +1,44 @@
+package i18n
+
+import (
+	"context"
+	"html/template"
+)
+
+type localeContextKey struct{}
+
+// WithLocale return a copy of ctx that carries locale, it could be used in a middleware to set current request's locale
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeContextKey{}, locale)
+}
+
+// LocaleFromContext return locale stored in ctx by `WithLocale`, or the package `Default` if it is not set
+// Use `I18n.LocaleFromContext` to fall back to the default locale of an instance set with `SetDefaultLocale`
+func LocaleFromContext(ctx context.Context) string {
+	if locale := localeFromContext(ctx); locale != "" {
+		return locale
+	}
+	return Default
+}
+
+// LocaleFromContext return locale stored in ctx by `WithLocale`, or the default locale of I18n if it is not set
+func (i18n *I18n) LocaleFromContext(ctx context.Context) string {
+	if locale := localeFromContext(ctx); locale != "" {
+		return locale
+	}
+	return i18n.getDefaultLocale()
+}
+
+func localeFromContext(ctx context.Context) string {
+	if ctx != nil {
+		if locale, ok := ctx.Value(localeContextKey{}).(string); ok {
+			return locale
+		}
+	}
+	return ""
+}
+
+// TFromContext translate with key and arguments, using the locale stored in ctx, or the default locale of I18n if it is not set
+func (i18n *I18n) TFromContext(ctx context.Context, key string, args ...interface{}) template.HTML {
+	return i18n.T(i18n.LocaleFromContext(ctx), key, args...)
+}
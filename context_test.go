@@ -0,0 +1,29 @@
+package i18n
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTFromContext(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "zh-CN", Value: "你好"})
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello"})
+
+	ctx := WithLocale(context.Background(), "zh-CN")
+	if result := i18n.TFromContext(ctx, "hello"); result != "你好" {
+		t.Errorf("should translate with locale from context, but got %v", result)
+	}
+
+	if result := i18n.TFromContext(context.Background(), "hello"); result != "Hello" {
+		t.Errorf("should translate with default locale if no locale in context, but got %v", result)
+	}
+
+	i18n.SetDefaultLocale("zh-CN")
+	if result := i18n.TFromContext(context.Background(), "hello"); result != "你好" {
+		t.Errorf("should translate with default locale of instance if no locale in context, but got %v", result)
+	}
+	if locale := LocaleFromContext(context.Background()); locale != Default {
+		t.Errorf("package LocaleFromContext should still fall back to package Default, but got %v", locale)
+	}
+}
@@ -0,0 +1,34 @@
+package i18n
+
+// SetDefaultLocale set default locale of the instance, it is used for blank locale and as the last fallback locale instead of the package-level `Default`
+func (i18n *I18n) SetDefaultLocale(locale string) {
+	i18n.defaultLocale = locale
+}
+
+// WithDefault call fn with a copy of I18n that translates to locale when T is called with blank locale, e.g: render an email in user's language
+// fn receives the copy instead of taking no arguments, because changing the default of the shared instance for the duration of fn would leak to other goroutines,
+// the copy shares backends and cache store with the instance, only its default locale is different
+func (i18n *I18n) WithDefault(locale string, fn func(*I18n)) {
+	clone := i18n.clone()
+	clone.defaultLocale = locale
+	fn(clone)
+}
+
+// getDefaultLocale return default locale of the instance, the package-level `Default` is used if the instance doesn't have one
+func (i18n *I18n) getDefaultLocale() string {
+	if i18n.defaultLocale != "" {
+		return i18n.defaultLocale
+	}
+	return Default
+}
+
+// warnPackageDefault log a deprecation warning once per instance, when blank locale falls back to the package-level `Default`
+func (i18n *I18n) warnPackageDefault() {
+	if i18n.defaultLocale != "" || i18n.defaultWarning == nil {
+		return
+	}
+
+	i18n.defaultWarning.Do(func() {
+		i18n.logf("Using package-level i18n.Default %v as default locale is deprecated, please use SetDefaultLocale instead", Default)
+	})
+}
@@ -0,0 +1,100 @@
+package i18n
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestWithDefault(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello"})
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "zh-CN", Value: "你好"})
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "de-DE", Value: "Hallo"})
+
+	var (
+		wg      sync.WaitGroup
+		results = map[string]string{}
+		mutex   sync.Mutex
+	)
+
+	for _, locale := range []string{"zh-CN", "de-DE"} {
+		wg.Add(1)
+		go func(locale string) {
+			defer wg.Done()
+			i18n.WithDefault(locale, func(i18n *I18n) {
+				var result string
+				for i := 0; i < 100; i++ {
+					if value := string(i18n.T("", "hello")); i > 0 && value != result {
+						t.Errorf("translation of %v should be isolated, but got %v", locale, value)
+					} else {
+						result = value
+					}
+				}
+
+				mutex.Lock()
+				results[locale] = result
+				mutex.Unlock()
+			})
+		}(locale)
+	}
+	wg.Wait()
+
+	if results["zh-CN"] != "你好" || results["de-DE"] != "Hallo" {
+		t.Errorf("should translate with default locale of callback, but got %v", results)
+	}
+
+	if result := i18n.T("", "hello"); result != "Hello" {
+		t.Errorf("default locale shouldn't leak out of callback, but got %v", result)
+	}
+}
+
+func TestSetDefaultLocale(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello"})
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "zh-CN", Value: "你好"})
+	i18n.AddTranslation(&Translation{Key: "bye", Locale: "zh-CN", Value: "再见"})
+
+	i18n.SetDefaultLocale("zh-CN")
+	if result := i18n.T("", "hello"); result != "你好" {
+		t.Errorf("should translate blank locale with default locale of instance, but got %v", result)
+	}
+
+	if result := i18n.T("de-DE", "bye"); result != "再见" {
+		t.Errorf("should fall back to default locale of instance, but got %v", result)
+	}
+}
+
+func TestPackageDefaultWarning(t *testing.T) {
+	i18n := New(&backend{})
+	logger := &testLogger{}
+	i18n.SetLogger(logger)
+
+	i18n.T("", "hello")
+	i18n.T("", "hello")
+	i18n.Default("Hello").T("", "hello")
+	if len(logger.messages) != 1 || !strings.Contains(logger.messages[0], "SetDefaultLocale") {
+		t.Errorf("should warn once for using package-level default, but got %v", logger.messages)
+	}
+
+	logger = &testLogger{}
+	i18n = New(&backend{})
+	i18n.SetLogger(logger)
+	i18n.SetDefaultLocale("en-US")
+	i18n.T("", "hello")
+	if len(logger.messages) != 0 {
+		t.Errorf("shouldn't warn if instance has default locale, but got %v", logger.messages)
+	}
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	New(&backend{}).T("", "hello")
+	if !strings.Contains(buf.String(), "SetDefaultLocale") {
+		t.Errorf("should warn with standard logger if no logger set, but got %v", buf.String())
+	}
+}
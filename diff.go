@@ -0,0 +1,68 @@
+package i18n
+
+import "fmt"
+
+// TranslationDiff a translation has different values in two backends
+type TranslationDiff struct {
+	Locale string
+	Key    string
+	A      string
+	B      string
+}
+
+// BackendDiff differences of translations between two backends, translations are ordered by locale then key
+type BackendDiff struct {
+	// OnlyInA translations only exist in backend a
+	OnlyInA []*Translation
+	// OnlyInB translations only exist in backend b
+	OnlyInB []*Translation
+	// Changed translations exist in both backends with different values
+	Changed []TranslationDiff
+	// Same count of translations exist in both backends with same value
+	Same int
+}
+
+// Equal return true if two backends have same translations
+func (diff BackendDiff) Equal() bool {
+	return len(diff.OnlyInA) == 0 && len(diff.OnlyInB) == 0 && len(diff.Changed) == 0
+}
+
+// String return counts of the diff, e.g: `2 only in a, 1 only in b, 3 changed, 10 same`
+func (diff BackendDiff) String() string {
+	return fmt.Sprintf("%v only in a, %v only in b, %v changed, %v same", len(diff.OnlyInA), len(diff.OnlyInB), len(diff.Changed), diff.Same)
+}
+
+// DiffBackends compare translations of backend a and b, it could be used to verify a migration copied all translations, e.g: from YAML files to database
+func (i18n *I18n) DiffBackends(a, b Backend) BackendDiff {
+	var (
+		diff          BackendDiff
+		translationsB = i18n.LoadFromBackend(b)
+		translations  = map[string]*Translation{}
+		matched       = map[string]bool{}
+	)
+
+	for _, translation := range translationsB {
+		translations[cacheKey(translation.Locale, translation.Key)] = translation
+	}
+
+	for _, translation := range i18n.LoadFromBackend(a) {
+		key := cacheKey(translation.Locale, translation.Key)
+		other, ok := translations[key]
+		switch {
+		case !ok:
+			diff.OnlyInA = append(diff.OnlyInA, translation)
+		case other.Value != translation.Value:
+			diff.Changed = append(diff.Changed, TranslationDiff{Locale: translation.Locale, Key: translation.Key, A: translation.Value, B: other.Value})
+		default:
+			diff.Same++
+		}
+		matched[key] = true
+	}
+
+	for _, translation := range translationsB {
+		if !matched[cacheKey(translation.Locale, translation.Key)] {
+			diff.OnlyInB = append(diff.OnlyInB, translation)
+		}
+	}
+	return diff
+}
@@ -0,0 +1,43 @@
+package i18n
+
+import "testing"
+
+func TestDiffBackends(t *testing.T) {
+	a := &sliceBackend{translations: []*Translation{
+		{Locale: "en-US", Key: "hello", Value: "Hello"},
+		{Locale: "en-US", Key: "bye", Value: "Bye"},
+		{Locale: "zh-CN", Key: "hello", Value: "你好"},
+		{Locale: "zh-CN", Key: "title", Value: "标题"},
+	}}
+	b := &sliceBackend{translations: []*Translation{
+		{Locale: "en-US", Key: "hello", Value: "Hello"},
+		{Locale: "en-US", Key: "bye", Value: "Goodbye"},
+		{Locale: "zh-CN", Key: "hello", Value: "你好"},
+		{Locale: "ja-JP", Key: "hello", Value: "こんにちは"},
+	}}
+
+	diff := New().DiffBackends(a, b)
+	if diff.Equal() {
+		t.Errorf("backends should be different")
+	}
+
+	if len(diff.OnlyInA) != 1 || diff.OnlyInA[0].Locale != "zh-CN" || diff.OnlyInA[0].Key != "title" {
+		t.Errorf("zh-CN title should be only in a, got %v", diff.OnlyInA)
+	}
+	if len(diff.OnlyInB) != 1 || diff.OnlyInB[0].Locale != "ja-JP" {
+		t.Errorf("ja-JP hello should be only in b, got %v", diff.OnlyInB)
+	}
+	if len(diff.Changed) != 1 || diff.Changed[0] != (TranslationDiff{Locale: "en-US", Key: "bye", A: "Bye", B: "Goodbye"}) {
+		t.Errorf("en-US bye should be changed, got %v", diff.Changed)
+	}
+	if diff.Same != 2 {
+		t.Errorf("2 translations should be same, got %v", diff.Same)
+	}
+	if diff.String() != "1 only in a, 1 only in b, 1 changed, 2 same" {
+		t.Errorf("unexpected summary %v", diff)
+	}
+
+	if diff := New().DiffBackends(a, a); !diff.Equal() || diff.Same != 4 {
+		t.Errorf("backend should equal to itself, got %v", diff)
+	}
+}
@@ -0,0 +1,18 @@
+package i18n
+
+import "golang.org/x/text/language"
+
+// rtlScripts scripts written from right to left
+var rtlScripts = map[string]bool{
+	"Adlm": true, "Arab": true, "Hebr": true, "Mand": true, "Nkoo": true,
+	"Rohg": true, "Samr": true, "Syrc": true, "Thaa": true, "Yezi": true,
+}
+
+// Direction return text direction `ltr` or `rtl` of locale based on its script, e.g: `ar` and `az-Arab` are `rtl`
+func Direction(locale string) string {
+	script, _ := language.Make(locale).Script()
+	if rtlScripts[script.String()] {
+		return "rtl"
+	}
+	return "ltr"
+}
@@ -0,0 +1,23 @@
+package i18n
+
+import "testing"
+
+func TestDirection(t *testing.T) {
+	directions := map[string]string{
+		"en-US":   "ltr",
+		"zh-CN":   "ltr",
+		"ru":      "ltr",
+		"az":      "ltr",
+		"ar":      "rtl",
+		"ar-EG":   "rtl",
+		"he":      "rtl",
+		"fa-IR":   "rtl",
+		"az-Arab": "rtl",
+	}
+
+	for locale, direction := range directions {
+		if result := Direction(locale); result != direction {
+			t.Errorf("direction of %v should be %v, but got %v", locale, direction, result)
+		}
+	}
+}
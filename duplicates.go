@@ -0,0 +1,39 @@
+package i18n
+
+import "strings"
+
+// Duplicates return values shared by multiple keys in locale, mapping value to sorted keys, blank values are ignored
+func (i18n *I18n) Duplicates(locale string) map[string][]string {
+	return i18n.duplicates(locale, false)
+}
+
+// DuplicatesIgnoreCase same as Duplicates, but compare values case-insensitively, values in the result are lower-cased
+func (i18n *I18n) DuplicatesIgnoreCase(locale string) map[string][]string {
+	return i18n.duplicates(locale, true)
+}
+
+func (i18n *I18n) duplicates(locale string, ignoreCase bool) map[string][]string {
+	var (
+		translations = i18n.LoadTranslations()
+		values       = map[string][]string{}
+	)
+
+	for _, translation := range sortTranslations(map[string]map[string]*Translation{locale: translations[locale]}) {
+		if translation.Value == "" {
+			continue
+		}
+
+		value := translation.Value
+		if ignoreCase {
+			value = strings.ToLower(value)
+		}
+		values[value] = append(values[value], translation.Key)
+	}
+
+	for value, keys := range values {
+		if len(keys) < 2 {
+			delete(values, value)
+		}
+	}
+	return values
+}
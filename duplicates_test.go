@@ -0,0 +1,30 @@
+package i18n
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDuplicates(t *testing.T) {
+	i18n := New(&mapBackend{translations: map[string]*Translation{
+		"en-US/form.save":   {Key: "form.save", Locale: "en-US", Value: "Save"},
+		"en-US/dialog.save": {Key: "dialog.save", Locale: "en-US", Value: "Save"},
+		"en-US/menu.save":   {Key: "menu.save", Locale: "en-US", Value: "save"},
+		"en-US/title":       {Key: "title", Locale: "en-US", Value: "Title"},
+		"en-US/blank1":      {Key: "blank1", Locale: "en-US", Value: ""},
+		"en-US/blank2":      {Key: "blank2", Locale: "en-US", Value: ""},
+		"zh-CN/form.save":   {Key: "form.save", Locale: "zh-CN", Value: "Save"},
+	}})
+
+	if duplicates := i18n.Duplicates("en-US"); !reflect.DeepEqual(duplicates, map[string][]string{"Save": {"dialog.save", "form.save"}}) {
+		t.Errorf("should find keys sharing same value, but got %v", duplicates)
+	}
+
+	if duplicates := i18n.DuplicatesIgnoreCase("en-US"); !reflect.DeepEqual(duplicates, map[string][]string{"save": {"dialog.save", "form.save", "menu.save"}}) {
+		t.Errorf("should find keys sharing same value case-insensitively, but got %v", duplicates)
+	}
+
+	if duplicates := i18n.Duplicates("zh-CN"); len(duplicates) != 0 {
+		t.Errorf("should find no duplicates, but got %v", duplicates)
+	}
+}
@@ -0,0 +1,11 @@
+package i18n
+
+import (
+	"fmt"
+	"html/template"
+)
+
+// TEnum translate enum value with key `enumName.value`, e.g: `order_status.shipped`, the stringer output will be returned if it is missing
+func (i18n *I18n) TEnum(locale, enumName string, value fmt.Stringer) template.HTML {
+	return i18n.Default(value.String()).T(locale, enumName+"."+value.String())
+}
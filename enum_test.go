@@ -0,0 +1,23 @@
+package i18n
+
+import "testing"
+
+type orderStatus int
+
+func (status orderStatus) String() string {
+	return [...]string{"pending", "shipped", "delivered"}[status]
+}
+
+func TestTEnum(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "order_status.pending", Locale: "zh-CN", Value: "待处理"})
+	i18n.AddTranslation(&Translation{Key: "order_status.shipped", Locale: "zh-CN", Value: "已发货"})
+
+	if result := i18n.TEnum("zh-CN", "order_status", orderStatus(1)); result != "已发货" {
+		t.Errorf("should translate enum value, but got %v", result)
+	}
+
+	if result := i18n.TEnum("zh-CN", "order_status", orderStatus(2)); result != "delivered" {
+		t.Errorf("missing enum value should fall back to stringer output, but got %v", result)
+	}
+}
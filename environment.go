@@ -0,0 +1,31 @@
+package i18n
+
+// SetEnvironment set environment name, translations under the environment namespace take precedence over others, e.g: `staging.home.title` overrides `home.title` for environment `staging`
+func (i18n *I18n) SetEnvironment(env string) {
+	i18n.environment = env
+}
+
+// findEnvironmentTranslation find translation of key for locale and its fallback locales, in each locale key under the environment namespace is tried before key itself
+// So `staging.home.title` of a fallback locale won't override `home.title` of the requested locale
+func (i18n *I18n) findEnvironmentTranslation(locale, key string) (Translation, bool) {
+	if i18n.environment == "" {
+		return i18n.findTranslation(locale, key)
+	}
+
+	for _, l := range append([]string{locale}, i18n.fallbackLocalesFor(locale)...) {
+		for _, k := range i18n.environmentKeys(key) {
+			if translation, ok := i18n.lookupTranslation(l, k); ok {
+				return translation, true
+			}
+		}
+	}
+	return Translation{}, false
+}
+
+// environmentKeys return keys to look up for key in a locale, key under the environment namespace goes first
+func (i18n *I18n) environmentKeys(key string) []string {
+	if i18n.environment == "" {
+		return []string{key}
+	}
+	return []string{joinKey(i18n.environment, key), key}
+}
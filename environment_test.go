@@ -0,0 +1,53 @@
+package i18n
+
+import "testing"
+
+func TestSetEnvironment(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "home.title", Locale: "en-US", Value: "Welcome"})
+	i18n.AddTranslation(&Translation{Key: "staging.home.title", Locale: "en-US", Value: "Welcome to staging"})
+	i18n.AddTranslation(&Translation{Key: "staging.home.title", Locale: "zh-CN", Value: "欢迎来到预发布环境"})
+
+	if result := i18n.T("en-US", "home.title"); result != "Welcome" {
+		t.Errorf("should return translation without environment, but got %v", result)
+	}
+
+	i18n.SetEnvironment("staging")
+	if result := i18n.T("en-US", "home.title"); result != "Welcome to staging" {
+		t.Errorf("environment translation should take precedence, but got %v", result)
+	}
+
+	if result := i18n.T("zh-CN", "home.title"); result != "欢迎来到预发布环境" {
+		t.Errorf("environment translation should take precedence for other locales, but got %v", result)
+	}
+
+	i18n.SetEnvironment("production")
+	if result := i18n.T("en-US", "home.title"); result != "Welcome" {
+		t.Errorf("should fall back to translation without environment, but got %v", result)
+	}
+}
+
+func TestSetEnvironmentWithFallbacks(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.FallbackLocales = map[string][]string{"zh-CN": {"en-US"}}
+	i18n.AddTranslation(&Translation{Key: "home.title", Locale: "zh-CN", Value: "首页"})
+	i18n.AddTranslation(&Translation{Key: "staging.home.title", Locale: "en-US", Value: "Staging home"})
+	i18n.SetEnvironment("staging")
+
+	if result := i18n.T("zh-CN", "home.title"); result != "首页" {
+		t.Errorf("translation of requested locale should take precedence over environment translation of fallback locale, but got %v", result)
+	}
+
+	explanation := i18n.TExplain("zh-CN", "home.title")
+	if explanation.SuppliedBy != "zh-CN" || explanation.Value != "首页" || len(explanation.Steps) != 2 {
+		t.Errorf("explanation should match lookups of T, but got %#v", explanation)
+	}
+
+	if result := i18n.T("zh-CN", "about.title"); result != "about.title" {
+		t.Errorf("missing translation should return key, but got %v", result)
+	}
+	i18n.AddTranslation(&Translation{Key: "staging.about.title", Locale: "en-US", Value: "Staging about"})
+	if result := i18n.T("zh-CN", "about.title"); result != "Staging about" {
+		t.Errorf("should fall back to environment translation of fallback locale, but got %v", result)
+	}
+}
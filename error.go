@@ -0,0 +1,16 @@
+package i18n
+
+import "html/template"
+
+// TError translate validation error of rule with key `errors.<rule>`, field name is translated with key `fields.<field>` and humanized if it is missing
+// Field name and params are filled into named placeholders, e.g: `errors.min: "{{.Field}} must be at least {{.min}} characters"`
+// Field name is also the first positional argument `{{$1}}`
+func (i18n *I18n) TError(locale, field, rule string, params map[string]interface{}) template.HTML {
+	fieldName := string(i18n.Default(humanizeKey(field)).T(locale, joinKey("fields", field)))
+
+	named := map[string]interface{}{"Field": fieldName}
+	for name, value := range params {
+		named[name] = value
+	}
+	return i18n.T(locale, joinKey("errors", rule), fieldName, named)
+}
@@ -0,0 +1,22 @@
+package i18n
+
+import "testing"
+
+func TestTError(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Locale: "en-US", Key: "errors.min", Value: "{{.Field}} must be at least {{.min}} characters"})
+	i18n.AddTranslation(&Translation{Locale: "zh-CN", Key: "errors.min", Value: "{{$1}}至少需要{{.min}}个字符"})
+	i18n.AddTranslation(&Translation{Locale: "zh-CN", Key: "fields.password", Value: "密码"})
+
+	if value := i18n.TError("en-US", "password", "min", map[string]interface{}{"min": 8}); value != "Password must be at least 8 characters" {
+		t.Errorf("field name should be humanized if it isn't translated, got %v", value)
+	}
+
+	if value := i18n.TError("zh-CN", "password", "min", map[string]interface{}{"min": 8}); value != "密码至少需要8个字符" {
+		t.Errorf("field name should be translated, got %v", value)
+	}
+
+	if value := i18n.TError("en-US", "user_name", "required", nil); value != "errors.required" {
+		t.Errorf("should return key of missing rule, got %v", value)
+	}
+}
@@ -0,0 +1,66 @@
+package i18n
+
+// ExplainStep a lookup of key in locale tried by TExplain
+type ExplainStep struct {
+	Locale string
+	Key    string
+	Found  bool
+}
+
+// Explanation explains how T looks up a translation, returned by TExplain
+type Explanation struct {
+	// Locale requested locale, default locale is used if it is blank
+	Locale string
+	// Key translation key looked up, with scope and alias resolved
+	Key string
+	// Steps lookups tried in order, in each locale translation under the environment namespace is tried first
+	Steps []ExplainStep
+	// SuppliedBy locale of the translation supplied value, it is blank if the translation is missing
+	SuppliedBy string
+	// Value raw value before parsing, it is the value T would use for missing translations, like the key or the default value set with `Default`
+	Value string
+	// MissingHandled is true if the value is produced by the missing handler set with `SetMissingHandler`
+	MissingHandled bool
+	// AutoCreate is true if T would auto create the translation because it is missing, translations of frozen or uncached locales aren't auto created
+	AutoCreate bool
+}
+
+// TExplain explain lookups of T for locale and key, including locales tried and which one supplied the value
+// It is a debugging tool, missing translations won't be auto created, but missing handler will be called like T
+func (i18n *I18n) TExplain(locale, key string) Explanation {
+	key = i18n.resolveKeyAlias(key)
+	if locale == "" {
+		locale = i18n.getDefaultLocale()
+	}
+
+	translationKey := key
+	if i18n.scope != "" {
+		translationKey = joinKey(i18n.scope, key)
+	}
+
+	var (
+		explanation = Explanation{Locale: locale, Key: translationKey}
+		locales     = append([]string{locale}, i18n.fallbackLocalesFor(locale)...)
+	)
+
+	for _, l := range locales {
+		for _, k := range i18n.environmentKeys(translationKey) {
+			translation, found := i18n.lookupTranslation(l, k)
+			explanation.Steps = append(explanation.Steps, ExplainStep{Locale: l, Key: k, Found: found})
+			if found {
+				explanation.SuppliedBy = l
+				explanation.Value = translation.Value
+				return explanation
+			}
+		}
+	}
+
+	if handled, ok := i18n.handleMissing(locale, translationKey); ok {
+		explanation.Value, explanation.MissingHandled = handled, true
+		return explanation
+	}
+
+	explanation.Value = i18n.returnedValue(key, i18n.autoCreatedValue(key))
+	explanation.AutoCreate = i18n.canAutoCreate(locale)
+	return explanation
+}
@@ -0,0 +1,82 @@
+package i18n
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTExplain(t *testing.T) {
+	backend := &sliceBackend{}
+	i18n := New(backend)
+	i18n.FallbackLocales = map[string][]string{"zh-TW": {"zh-CN"}}
+	i18n.AddTranslation(&Translation{Locale: "zh-CN", Key: "hello", Value: "你好"})
+	i18n.AddTranslation(&Translation{Locale: "en-US", Key: "bye", Value: "Bye"})
+
+	explanation := i18n.TExplain("zh-TW", "hello")
+	expected := Explanation{
+		Locale:     "zh-TW",
+		Key:        "hello",
+		Steps:      []ExplainStep{{Locale: "zh-TW", Key: "hello"}, {Locale: "zh-CN", Key: "hello", Found: true}},
+		SuppliedBy: "zh-CN",
+		Value:      "你好",
+	}
+	if !reflect.DeepEqual(explanation, expected) {
+		t.Errorf("expect explanation %+v, but got %+v", expected, explanation)
+	}
+
+	if explanation := i18n.TExplain("zh-TW", "bye"); explanation.SuppliedBy != "en-US" || len(explanation.Steps) != 3 {
+		t.Errorf("should fall back to default locale, got %+v", explanation)
+	}
+
+	explanation = i18n.TExplain("zh-TW", "missing")
+	if !explanation.AutoCreate || explanation.SuppliedBy != "" || len(explanation.Steps) != 3 {
+		t.Errorf("should be explained as auto created, got %+v", explanation)
+	}
+	if len(backend.translations) != 0 {
+		t.Errorf("missing translation shouldn't be auto created, got %v", backend.translations)
+	}
+}
+
+func TestTExplainWithEnvironment(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.SetEnvironment("staging")
+	i18n.AddTranslation(&Translation{Locale: "en-US", Key: "title", Value: "Title"})
+
+	explanation := i18n.Scope("home").TExplain("", "title")
+	if explanation.Locale != "en-US" || explanation.Key != "home.title" || explanation.AutoCreate != true {
+		t.Errorf("unexpected explanation %+v", explanation)
+	}
+
+	if steps := explanation.Steps; len(steps) != 2 || steps[0].Key != "staging.home.title" || steps[1].Key != "home.title" {
+		t.Errorf("environment namespace should be tried first, got %+v", steps)
+	}
+}
+
+func TestTExplainMissing(t *testing.T) {
+	i18n := New(&backend{})
+
+	if explanation := i18n.Default("Hello").TExplain("en-US", "hello"); !explanation.AutoCreate || explanation.Value != "Hello" {
+		t.Errorf("should explain default value would be auto created, got %+v", explanation)
+	}
+
+	i18n.SetMissingBehavior(ReturnEmpty)
+	if explanation := i18n.Default("Hello").TExplain("en-US", "hello"); !explanation.AutoCreate || explanation.Value != "" {
+		t.Errorf("should explain blank value would be used, got %+v", explanation)
+	}
+	i18n.SetMissingBehavior(ReturnKey)
+
+	i18n.FreezeLocale("de-DE")
+	if explanation := i18n.TExplain("de-DE", "hello"); explanation.AutoCreate || explanation.Value != "hello" {
+		t.Errorf("missing translation of frozen locale shouldn't be explained as auto created, got %+v", explanation)
+	}
+
+	i18n.LoadTranslationsFor("en-US")
+	if explanation := i18n.TExplain("zh-CN", "hello"); explanation.AutoCreate {
+		t.Errorf("missing translation of uncached locale shouldn't be explained as auto created, got %+v", explanation)
+	}
+
+	i18n.SetMissingHandler(func(locale, key string) string { return "Remote " + key })
+	if explanation := i18n.TExplain("en-US", "hello"); explanation.AutoCreate || !explanation.MissingHandled || explanation.Value != "Remote hello" {
+		t.Errorf("value produced by missing handler shouldn't be explained as auto created, got %+v", explanation)
+	}
+}
@@ -0,0 +1,116 @@
+package i18n
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ExportScope export translations of locale under scope, supported formats: json, yaml, csv
+// Keys are exported with the scope, e.g: scope `home` exports `home.title`, `home.welcome`, but not `homepage.title`
+// Exported files could be imported back with `ImportForLocale`
+func (i18n *I18n) ExportScope(scope, locale string, w io.Writer, format string) error {
+	values := map[string]string{}
+	for key, translation := range i18n.LoadTranslations()[locale] {
+		if inScope(key, scope) {
+			values[key] = translation.Value
+		}
+	}
+
+	switch strings.ToLower(format) {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(values)
+	case "yaml", "yml":
+		slice, err := nestTranslations(values)
+		if err != nil {
+			return err
+		}
+
+		content, err := yaml.Marshal(yaml.MapSlice{{Key: locale, Value: slice}})
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(content)
+		return err
+	case "csv":
+		writer := csv.NewWriter(w)
+		writer.Write([]string{"key", locale})
+		for _, key := range sortedKeys(values) {
+			writer.Write([]string{key, values[key]})
+		}
+		writer.Flush()
+		return writer.Error()
+	}
+	return fmt.Errorf("unsupported export format: %v", format)
+}
+
+// inScope check key is the scope itself or under the scope, blank scope includes all keys
+func inScope(key, scope string) bool {
+	if scope == "" {
+		return true
+	}
+
+	keySegments, scopeSegments := SplitKey(key), SplitKey(scope)
+	if len(keySegments) < len(scopeSegments) {
+		return false
+	}
+	for idx, segment := range scopeSegments {
+		if keySegments[idx] != segment {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedKeys(values map[string]string) []string {
+	var keys []string
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// nestTranslations convert dotted keys to nested YAML map, like files used for YAML backend
+func nestTranslations(values map[string]string) (yaml.MapSlice, error) {
+	var slice yaml.MapSlice
+	for _, key := range sortedKeys(values) {
+		var err error
+		if slice, err = insertTranslation(slice, SplitKey(key), values[key]); err != nil {
+			return nil, fmt.Errorf("failed to export %v, got: %v", key, err)
+		}
+	}
+	return slice, nil
+}
+
+func insertTranslation(slice yaml.MapSlice, keys []string, value string) (yaml.MapSlice, error) {
+	for idx, item := range slice {
+		if item.Key != keys[0] {
+			continue
+		}
+
+		child, ok := item.Value.(yaml.MapSlice)
+		if len(keys) == 1 || !ok {
+			return nil, fmt.Errorf("key %v is used as both value and scope", keys[0])
+		}
+
+		child, err := insertTranslation(child, keys[1:], value)
+		slice[idx].Value = child
+		return slice, err
+	}
+
+	if len(keys) == 1 {
+		return append(slice, yaml.MapItem{Key: keys[0], Value: value}), nil
+	}
+
+	child, err := insertTranslation(nil, keys[1:], value)
+	return append(slice, yaml.MapItem{Key: keys[0], Value: child}), err
+}
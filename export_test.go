@@ -0,0 +1,91 @@
+package i18n
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestExportScope(t *testing.T) {
+	i18n := New(&mapBackend{translations: map[string]*Translation{
+		"en-US/home":          {Key: "home", Locale: "en-US", Value: "Home"},
+		"en-US/home.title":    {Key: "home.title", Locale: "en-US", Value: "Welcome"},
+		"en-US/homepage.name": {Key: "homepage.name", Locale: "en-US", Value: "Homepage"},
+		"en-US/user.name":     {Key: "user.name", Locale: "en-US", Value: "Name"},
+		"zh-CN/home.title":    {Key: "home.title", Locale: "zh-CN", Value: "欢迎"},
+	}})
+
+	var buf bytes.Buffer
+	if err := i18n.ExportScope("home", "en-US", &buf, "json"); err != nil {
+		t.Fatalf("failed to export scope, got %v", err)
+	}
+
+	var values map[string]string
+	json.Unmarshal(buf.Bytes(), &values)
+	if !reflect.DeepEqual(values, map[string]string{"home": "Home", "home.title": "Welcome"}) {
+		t.Errorf("should only export keys under scope, but got %v", values)
+	}
+
+	buf.Reset()
+	if err := i18n.ExportScope("home", "en-US", &buf, "csv"); err != nil || buf.String() != "key,en-US\nhome,Home\nhome.title,Welcome\n" {
+		t.Errorf("should export keys under scope as CSV, but got %v, %v", buf.String(), err)
+	}
+
+	other := New(&mapBackend{translations: map[string]*Translation{}})
+	if err := other.ImportForLocale("en-US", &buf, "csv"); err != nil || other.T("en-US", "home.title") != "Welcome" {
+		t.Errorf("exported translations should be imported back, but got %v", err)
+	}
+
+	if err := i18n.ExportScope("home", "en-US", &buf, "xml"); err == nil {
+		t.Errorf("should return error for unsupported format")
+	}
+}
+
+func TestNestTranslations(t *testing.T) {
+	slice, err := nestTranslations(map[string]string{"home.title": "Welcome", "home.menu.about": "About", "user": "User"})
+	expected := yaml.MapSlice{
+		{Key: "home", Value: yaml.MapSlice{
+			{Key: "menu", Value: yaml.MapSlice{{Key: "about", Value: "About"}}},
+			{Key: "title", Value: "Welcome"},
+		}},
+		{Key: "user", Value: "User"},
+	}
+
+	if err != nil || !reflect.DeepEqual(slice, expected) {
+		t.Errorf("should nest translations, but got %v, %v", slice, err)
+	}
+
+	if _, err := nestTranslations(map[string]string{"home": "Home", "home.title": "Welcome"}); err == nil {
+		t.Errorf("should return error if key is both value and scope")
+	}
+
+	slice, err = nestTranslations(map[string]string{`v1\.2.title`: "Version 1.2", "v1.title": "Version 1"})
+	expected = yaml.MapSlice{
+		{Key: "v1", Value: yaml.MapSlice{{Key: "title", Value: "Version 1"}}},
+		{Key: "v1.2", Value: yaml.MapSlice{{Key: "title", Value: "Version 1.2"}}},
+	}
+	if err != nil || !reflect.DeepEqual(slice, expected) {
+		t.Errorf("escaped dots shouldn't be treated as separators, but got %v, %v", slice, err)
+	}
+}
+
+func TestExportScopeWithEscapedDots(t *testing.T) {
+	i18n := New(&mapBackend{translations: map[string]*Translation{
+		`en-US/v1\.2.title`: {Key: `v1\.2.title`, Locale: "en-US", Value: "Version 1.2"},
+		"en-US/v1.title":    {Key: "v1.title", Locale: "en-US", Value: "Version 1"},
+	}})
+
+	var buf bytes.Buffer
+	if err := i18n.ExportScope("v1", "en-US", &buf, "json"); err != nil {
+		t.Fatalf("failed to export scope, got %v", err)
+	}
+
+	var values map[string]string
+	json.Unmarshal(buf.Bytes(), &values)
+	if !reflect.DeepEqual(values, map[string]string{"v1.title": "Version 1"}) {
+		t.Errorf("keys with escaped dots shouldn't be exported under scope of their first part, but got %v", values)
+	}
+}
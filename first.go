@@ -0,0 +1,30 @@
+package i18n
+
+import "html/template"
+
+// TFirst translate key with the first locale has translation in locales, fallback locales and default locale are only tried if they are included in locales
+// Missing value is returned like T if none of locales has translation, but it won't be auto created
+func (i18n *I18n) TFirst(locales []string, key string, args ...interface{}) template.HTML {
+	key = i18n.resolveKeyAlias(key)
+
+	translationKey := key
+	if i18n.scope != "" {
+		translationKey = joinKey(i18n.scope, key)
+	}
+
+	for _, locale := range locales {
+		i18n.recordLookup(locale, translationKey, args)
+		for _, k := range i18n.environmentKeys(translationKey) {
+			if translation, ok := i18n.lookupTranslation(locale, k); ok {
+				return template.HTML(i18n.render(locale, key, translation.Value, args))
+			}
+		}
+	}
+
+	var locale = i18n.getDefaultLocale()
+	if len(locales) > 0 {
+		locale = locales[0]
+	}
+
+	return template.HTML(i18n.render(locale, key, i18n.returnedValue(key, i18n.autoCreatedValue(key)), args))
+}
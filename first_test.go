@@ -0,0 +1,38 @@
+package i18n
+
+import "testing"
+
+func TestTFirst(t *testing.T) {
+	backend := &sliceBackend{}
+	i18n := New(backend)
+	i18n.FallbackLocales = map[string][]string{"pt-BR": {"en-US"}}
+	i18n.AddTranslation(&Translation{Locale: "en-US", Key: "hello", Value: "Hello {{$1}}"})
+	i18n.AddTranslation(&Translation{Locale: "es-ES", Key: "hello", Value: "Hola {{$1}}"})
+	i18n.AddTranslation(&Translation{Locale: "pt-PT", Key: "bye", Value: "Adeus"})
+	i18n.AddTranslation(&Translation{Locale: "en-US", Key: "bye", Value: "Bye"})
+
+	if value := i18n.TFirst([]string{"pt-BR", "es-ES", "en-US"}, "hello", "Ana"); value != "Hola Ana" {
+		t.Errorf("should use the first locale has translation instead of configured fallbacks, got %v", value)
+	}
+
+	if value := i18n.TFirst([]string{"pt-BR", "pt-PT"}, "bye"); value != "Adeus" {
+		t.Errorf("should use related language in the list, got %v", value)
+	}
+
+	if value := i18n.TFirst([]string{"pt-BR", "es-ES"}, "bye"); value != "bye" {
+		t.Errorf("default locale shouldn't be tried if it isn't in the list, got %v", value)
+	}
+
+	if value := i18n.Default("See you").TFirst([]string{"ja-JP"}, "see_you"); value != "See you" {
+		t.Errorf("should return default value if none of locales has translation, got %v", value)
+	}
+
+	if len(backend.translations) != 0 {
+		t.Errorf("missing translations shouldn't be auto created, got %v", backend.translations)
+	}
+
+	i18n.SeedFromKey = true
+	if value, expected := i18n.TFirst([]string{"ja-JP"}, "sign_up"), i18n.T("ja-JP", "sign_up"); value != expected || value != "Sign up" {
+		t.Errorf("missing value should be same as T, expect %v, but got %v", expected, value)
+	}
+}
@@ -0,0 +1,60 @@
+package i18n
+
+import (
+	"fmt"
+
+	"github.com/theplant/cldr"
+)
+
+// Formatter format translation value with arguments
+type Formatter interface {
+	Format(locale, value string, args ...interface{}) (string, error)
+}
+
+// FormatterFunc adapter to use a func as Formatter
+type FormatterFunc func(locale, value string, args ...interface{}) (string, error)
+
+// Format call fc with locale, value and arguments
+func (fc FormatterFunc) Format(locale, value string, args ...interface{}) (string, error) {
+	return fc(locale, value, args...)
+}
+
+var (
+	// CLDRFormatter format value as CLDR pattern, e.g: `Hello {{$1}}`, it is the default formatter, patterns are parsed with the CLDR provider of I18n
+	CLDRFormatter Formatter = cldrFormatter{}
+	// SprintfFormatter format value with `fmt.Sprintf`, e.g: `Hello %s`, value without arguments will be returned as it is
+	// It returns error if verbs of value reference more arguments than given, so `%!d(MISSING)` won't be rendered
+	SprintfFormatter Formatter = sprintfFormatter{}
+)
+
+type cldrFormatter struct{}
+
+func (cldrFormatter) Format(locale, value string, args ...interface{}) (string, error) {
+	return cldr.Parse(locale, value, args...)
+}
+
+type sprintfFormatter struct{}
+
+func (sprintfFormatter) Format(locale, value string, args ...interface{}) (string, error) {
+	if len(args) == 0 {
+		return value, nil
+	}
+
+	var missing int
+	replaceSprintfVerbs(value, func(verb string, argNum int) string {
+		if argNum > len(args) && argNum > missing {
+			missing = argNum
+		}
+		return verb
+	})
+
+	if missing > 0 {
+		return "", fmt.Errorf("verbs reference argument %v, but %v arguments given", missing, len(args))
+	}
+	return fmt.Sprintf(value, args...), nil
+}
+
+// SetFormatter set formatter used to apply arguments to translation values
+func (i18n *I18n) SetFormatter(formatter Formatter) {
+	i18n.formatter = formatter
+}
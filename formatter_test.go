@@ -0,0 +1,30 @@
+package i18n
+
+import "testing"
+
+func TestSprintfFormatter(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello %s, you have %d messages"})
+	i18n.AddTranslation(&Translation{Key: "discount", Locale: "en-US", Value: "100% off"})
+
+	i18n.SetFormatter(SprintfFormatter)
+	if result := i18n.T("en-US", "hello", "Jinzhu", 3); result != "Hello Jinzhu, you have 3 messages" {
+		t.Errorf("should format translation with Sprintf, but got %v", result)
+	}
+
+	if result := i18n.T("en-US", "discount"); result != "100% off" {
+		t.Errorf("translation without arguments should be returned as it is, but got %v", result)
+	}
+}
+
+func TestFormatterFunc(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello"})
+
+	i18n.SetFormatter(FormatterFunc(func(locale, value string, args ...interface{}) (string, error) {
+		return locale + ": " + value, nil
+	}))
+	if result := i18n.T("en-US", "hello"); result != "en-US: Hello" {
+		t.Errorf("should format translation with custom formatter, but got %v", result)
+	}
+}
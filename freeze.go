@@ -0,0 +1,42 @@
+package i18n
+
+import "sync"
+
+type frozenLocales struct {
+	mutex   sync.RWMutex
+	locales map[string]bool
+}
+
+// FreezeLocale stop T from auto creating missing translations for locale, fallback or default value is returned instead
+// It protects finalized locales from being marked incomplete by new keys
+func (i18n *I18n) FreezeLocale(locale string) {
+	if i18n.frozen == nil {
+		i18n.frozen = &frozenLocales{locales: map[string]bool{}}
+	}
+
+	i18n.frozen.mutex.Lock()
+	i18n.frozen.locales[locale] = true
+	i18n.frozen.mutex.Unlock()
+}
+
+// UnfreezeLocale allow T to auto create missing translations for locale again
+func (i18n *I18n) UnfreezeLocale(locale string) {
+	if i18n.frozen == nil {
+		return
+	}
+
+	i18n.frozen.mutex.Lock()
+	delete(i18n.frozen.locales, locale)
+	i18n.frozen.mutex.Unlock()
+}
+
+// IsFrozen return true if locale is frozen with `FreezeLocale`
+func (i18n *I18n) IsFrozen(locale string) bool {
+	if i18n.frozen == nil {
+		return false
+	}
+
+	i18n.frozen.mutex.RLock()
+	defer i18n.frozen.mutex.RUnlock()
+	return i18n.frozen.locales[locale]
+}
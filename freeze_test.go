@@ -0,0 +1,35 @@
+package i18n
+
+import "testing"
+
+func TestFreezeLocale(t *testing.T) {
+	backend := &sliceBackend{}
+	i18n := New(backend)
+	i18n.AddTranslation(&Translation{Locale: "en-US", Key: "hello", Value: "Hello"})
+	i18n.FreezeLocale("zh-CN")
+
+	if !i18n.IsFrozen("zh-CN") || i18n.IsFrozen("en-US") {
+		t.Errorf("only zh-CN should be frozen")
+	}
+
+	if value := i18n.T("zh-CN", "hello"); value != "Hello" {
+		t.Errorf("frozen locale should fall back to default locale, got %v", value)
+	}
+	if value := i18n.Default("Default Title").T("zh-CN", "title"); value != "Default Title" {
+		t.Errorf("frozen locale should return default value, got %v", value)
+	}
+	if len(backend.translations) != 0 {
+		t.Errorf("missing translations of frozen locale shouldn't be auto created, got %v", backend.translations)
+	}
+
+	i18n.T("en-US", "title")
+	if len(backend.translations) != 1 || backend.translations[0].Locale != "en-US" {
+		t.Errorf("missing translations of other locales should be auto created, got %v", backend.translations)
+	}
+
+	i18n.UnfreezeLocale("zh-CN")
+	i18n.T("zh-CN", "title")
+	if len(backend.translations) != 2 {
+		t.Errorf("missing translations should be auto created after unfreezing, got %v", backend.translations)
+	}
+}
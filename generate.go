@@ -0,0 +1,49 @@
+package i18n
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"strconv"
+)
+
+// GenerateGo generate Go source of package pkg with non-blank translations as a map `Translations` and an accessor `T`, so small tools could embed translations without this package
+func (i18n *I18n) GenerateGo(pkg string, w io.Writer) error {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by github.com/qor/i18n; DO NOT EDIT.\n\npackage %v\n\n", pkg)
+	buf.WriteString("// Translations translations as locale => key => value\n")
+	buf.WriteString("var Translations = map[string]map[string]string{\n")
+
+	var locale string
+	for _, translation := range i18n.LoadTranslationsSorted() {
+		if translation.Value == "" {
+			continue
+		}
+
+		if translation.Locale != locale {
+			if locale != "" {
+				buf.WriteString("},\n")
+			}
+			locale = translation.Locale
+			fmt.Fprintf(&buf, "%v: {\n", strconv.Quote(locale))
+		}
+		fmt.Fprintf(&buf, "%v: %v,\n", strconv.Quote(translation.Key), strconv.Quote(translation.Value))
+	}
+
+	if locale != "" {
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n\n")
+	buf.WriteString("// T return translation of key for locale, or key itself if it is missing\n")
+	buf.WriteString("func T(locale, key string) string {\n")
+	buf.WriteString("if value, ok := Translations[locale][key]; ok {\nreturn value\n}\nreturn key\n}\n")
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format generated source, got: %v", err)
+	}
+
+	_, err = w.Write(source)
+	return err
+}
@@ -0,0 +1,55 @@
+package i18n
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+func TestGenerateGo(t *testing.T) {
+	i18n := New(&sliceBackend{translations: []*Translation{
+		{Key: "hello", Locale: "en-US", Value: "Hello \"{{$1}}\"\n"},
+		{Key: "hello", Locale: "zh-CN", Value: "你好"},
+		{Key: "missing", Locale: "zh-CN", Value: ""},
+	}})
+
+	var buf bytes.Buffer
+	if err := i18n.GenerateGo("translations", &buf); err != nil {
+		t.Fatalf("failed to generate Go source, got %v", err)
+	}
+
+	if formatted, err := format.Source(buf.Bytes()); err != nil || !bytes.Equal(formatted, buf.Bytes()) {
+		t.Errorf("generated source should be gofmt-clean, got %v", buf.String())
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "translations.go", buf.Bytes(), 0)
+	if err != nil {
+		t.Fatalf("failed to parse generated source, got %v", err)
+	}
+
+	pkg, err := (&types.Config{}).Check("translations", fset, []*ast.File{file}, nil)
+	if err != nil {
+		t.Fatalf("generated source should compile, got %v", err)
+	}
+
+	if pkg.Name() != "translations" || pkg.Scope().Lookup("T") == nil || pkg.Scope().Lookup("Translations") == nil {
+		t.Errorf("generated source should have Translations and T")
+	}
+
+	source := buf.String()
+	for _, str := range []string{`"hello": "Hello \"{{$1}}\"\n"`, `"zh-CN": {`, `"hello": "你好"`} {
+		if !strings.Contains(source, str) {
+			t.Errorf("generated source should contain %v, but got %v", str, source)
+		}
+	}
+
+	if strings.Contains(source, `"missing"`) {
+		t.Errorf("blank translations shouldn't be generated")
+	}
+}
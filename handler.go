@@ -0,0 +1,61 @@
+package i18n
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/qor/qor"
+)
+
+// SetCurrentUserFunc set func to get current user of request, `JSONHandler` only serves locales the user could view when set
+func (i18n *I18n) SetCurrentUserFunc(fc func(*http.Request) qor.CurrentUser) {
+	i18n.currentUserFunc = fc
+}
+
+// JSONHandler return a http handler that serves translations of the requested locale as a flat JSON map, e.g: `{"home.title": "Home"}`
+// Responses have an ETag computed like `Version` from the same cached translations as the body, so clients could cache translations until they change
+func (i18n *I18n) JSONHandler(localeFromReq func(*http.Request) string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		locale := localeFromReq(req)
+		if locale == "" {
+			locale = i18n.getDefaultLocale()
+			i18n.warnPackageDefault()
+		}
+
+		if i18n.currentUserFunc != nil {
+			var viewable bool
+			for _, l := range getAvailableLocales(req, i18n.currentUserFunc(req)) {
+				if l == locale {
+					viewable = true
+				}
+			}
+
+			if !viewable {
+				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+				return
+			}
+		}
+
+		i18n.loadLocaleLazily(locale)
+		translations := i18n.cachedTranslationsOf(locale)
+
+		etag := `"` + versionOf(translations) + `"`
+		w.Header().Set("ETag", etag)
+		if req.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		values := map[string]string{}
+		for _, translation := range translations {
+			if translation.Value != "" {
+				values[translation.Key] = translation.Value
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		encoder := json.NewEncoder(w)
+		encoder.SetEscapeHTML(false)
+		encoder.Encode(values)
+	})
+}
@@ -0,0 +1,93 @@
+package i18n
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/qor/qor"
+)
+
+type viewer struct {
+	locales []string
+}
+
+func (viewer) DisplayName() string {
+	return "viewer"
+}
+
+func (user viewer) ViewableLocales() []string {
+	return user.locales
+}
+
+func TestJSONHandler(t *testing.T) {
+	i18n := New(&sliceBackend{translations: []*Translation{
+		{Key: "home.title", Locale: "zh-CN", Value: "首页"},
+		{Key: "home.welcome", Locale: "zh-CN", Value: "<b>欢迎</b>"},
+		{Key: "home.missing", Locale: "zh-CN", Value: ""},
+		{Key: "home.title", Locale: "en-US", Value: "Home"},
+	}})
+	handler := i18n.JSONHandler(func(req *http.Request) string { return req.URL.Query().Get("locale") })
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/translations?locale=zh-CN", nil))
+
+	var values map[string]string
+	if err := json.Unmarshal(recorder.Body.Bytes(), &values); err != nil {
+		t.Fatalf("should return JSON, but got %v", err)
+	}
+
+	if expected := map[string]string{"home.title": "首页", "home.welcome": "<b>欢迎</b>"}; !reflect.DeepEqual(values, expected) {
+		t.Errorf("should return translations of locale %v, but got %v", expected, values)
+	}
+
+	etag := recorder.Header().Get("ETag")
+	if etag != `"`+i18n.Version("zh-CN")+`"` {
+		t.Errorf("should return ETag computed with version, but got %v", etag)
+	}
+
+	req := httptest.NewRequest("GET", "/translations?locale=zh-CN", nil)
+	req.Header.Set("If-None-Match", etag)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	if recorder.Code != http.StatusNotModified || recorder.Body.Len() != 0 {
+		t.Errorf("should return not modified for matched ETag, but got %v", recorder.Code)
+	}
+
+	i18n.AddTranslation(&Translation{Key: "home.added", Locale: "zh-CN", Value: "新增"})
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	values = nil
+	if err := json.Unmarshal(recorder.Body.Bytes(), &values); err != nil || values["home.added"] != "新增" {
+		t.Errorf("should return translations added to cache store, but got %v", values)
+	}
+	if recorder.Header().Get("ETag") == etag {
+		t.Errorf("ETag should be changed after translation added to cache store")
+	}
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/translations", nil))
+	if err := json.Unmarshal(recorder.Body.Bytes(), &values); err != nil || values["home.title"] != "Home" {
+		t.Errorf("should return translations of default locale, but got %v", values)
+	}
+}
+
+func TestJSONHandlerWithViewableLocales(t *testing.T) {
+	i18n := New(&sliceBackend{translations: []*Translation{{Key: "home.title", Locale: "zh-CN", Value: "首页"}}})
+	i18n.SetCurrentUserFunc(func(req *http.Request) qor.CurrentUser { return viewer{locales: []string{"en-US"}} })
+	handler := i18n.JSONHandler(func(req *http.Request) string { return req.URL.Query().Get("locale") })
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/translations?locale=zh-CN", nil))
+	if recorder.Code != http.StatusForbidden {
+		t.Errorf("should forbid locales user couldn't view, but got %v", recorder.Code)
+	}
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/translations?locale=en-US", nil))
+	if recorder.Code != http.StatusOK {
+		t.Errorf("should serve locales user could view, but got %v", recorder.Code)
+	}
+}
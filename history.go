@@ -0,0 +1,37 @@
+package i18n
+
+import "time"
+
+// TranslationVersion a previous value of translation
+type TranslationVersion struct {
+	Value     string
+	CreatedAt time.Time
+}
+
+// HistoryBackend is an optional interface for backends that could keep history of translations
+// Previous value will be recorded with SaveTranslationVersion when a translation is changed by `SaveTranslation`
+type HistoryBackend interface {
+	SaveTranslationVersion(translation *Translation, version TranslationVersion) error
+	TranslationHistory(locale, key string) ([]TranslationVersion, error)
+}
+
+func recordHistory(backend Backend, translation *Translation, previousValue string) {
+	if historyBackend, ok := backend.(HistoryBackend); ok {
+		historyBackend.SaveTranslationVersion(translation, TranslationVersion{Value: previousValue, CreatedAt: time.Now()})
+	}
+}
+
+// History return previous values of translation from all backends support history, an empty slice will be returned if none of them supports it
+func (i18n *I18n) History(locale, key string) ([]TranslationVersion, error) {
+	versions := []TranslationVersion{}
+	for _, backend := range i18n.Backends {
+		if historyBackend, ok := backend.(HistoryBackend); ok {
+			results, err := historyBackend.TranslationHistory(locale, key)
+			if err != nil {
+				return versions, err
+			}
+			versions = append(versions, results...)
+		}
+	}
+	return versions, nil
+}
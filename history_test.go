@@ -0,0 +1,50 @@
+package i18n
+
+import "testing"
+
+type historyBackend struct {
+	backend
+	versions map[string][]TranslationVersion
+}
+
+func (b *historyBackend) SaveTranslationVersion(t *Translation, version TranslationVersion) error {
+	b.versions[cacheKey(t.Locale, t.Key)] = append(b.versions[cacheKey(t.Locale, t.Key)], version)
+	return nil
+}
+
+func (b *historyBackend) TranslationHistory(locale, key string) ([]TranslationVersion, error) {
+	return b.versions[cacheKey(locale, key)], nil
+}
+
+func TestHistory(t *testing.T) {
+	i18n := New(&historyBackend{versions: map[string][]TranslationVersion{}})
+
+	i18n.SaveTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello"})
+	i18n.SaveTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello World"})
+	i18n.SaveTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hi"})
+
+	versions, err := i18n.History("en-US", "hello")
+	if err != nil {
+		t.Fatalf("failed to get history, got %v", err)
+	}
+
+	if len(versions) != 2 || versions[0].Value != "Hello" || versions[1].Value != "Hello World" {
+		t.Errorf("should record previous values, but got %v", versions)
+	}
+
+	for _, version := range versions {
+		if version.CreatedAt.IsZero() {
+			t.Errorf("version should have timestamp")
+		}
+	}
+}
+
+func TestHistoryWithoutHistoryBackend(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.SaveTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello"})
+	i18n.SaveTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hi"})
+
+	if versions, err := i18n.History("en-US", "hello"); err != nil || versions == nil || len(versions) != 0 {
+		t.Errorf("should return empty history for backends don't support history, but got %v, %v", versions, err)
+	}
+}
@@ -4,19 +4,24 @@ import (
 	"errors"
 	"fmt"
 	"html/template"
-	"io/ioutil"
+	"log"
 	"net/http"
-	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/qor/cache"
 	"github.com/qor/cache/memory"
 	"github.com/qor/qor"
 	"github.com/qor/qor/utils"
-	"github.com/theplant/cldr"
+	"golang.org/x/text/unicode/norm"
 )
 
-// Default default locale for i18n
+// Default default locale for i18n, it is shared by all instances, prefer `SetDefaultLocale` to set default locale of an instance
 var Default = "en-US"
 
 // I18n struct that hold all translations
@@ -27,6 +32,68 @@ type I18n struct {
 	FallbackLocales map[string][]string
 	fallbackLocales []string
 	cacheStore      cache.CacheStoreInterface
+
+	// HumanizeMissing return humanized key for missing translations, e.g: `home.welcome_message` => `Welcome message`
+	HumanizeMissing bool
+	// NormalizeWhitespace trim and collapse whitespaces of translation values when adding or saving them
+	NormalizeWhitespace bool
+	// NormalizeUnicode normalize translation keys to NFC when saving and looking up, so composed and decomposed characters match the same key
+	NormalizeUnicode bool
+	// NormalizeUnicodeValues normalize translation values to NFC when adding or saving them
+	NormalizeUnicodeValues bool
+	// Debug validate arguments passed to T match placeholders of translations, mismatches are reported to OnParseError
+	Debug bool
+	// LazyPerLocale only load translations of the default locale at startup, other locales are loaded when they are looked up the first time, see `NewLazy`
+	LazyPerLocale bool
+	// AsyncAutoCreate auto create missing translations in background, so T won't wait for backends, duplicate writes of same key are coalesced
+	// Use `FlushAutoCreate` to wait until queued translations are created
+	AsyncAutoCreate bool
+	// SeedFromKey auto create missing translations with humanized last segment of key as value, or the default value set with `Default`, e.g: `home.welcome_message` => `Welcome message`
+	// Seeded translations are still marked as Auto, so they could be found with `Placeholders`
+	SeedFromKey bool
+	// MarkUntranslated wrap auto-created and fallback values with `<span class="i18n-missing">` to spot untranslated strings in QA, it should be off in production
+	MarkUntranslated bool
+	// ValidateLocales reject saving translations whose locale isn't a well-formed BCP 47 tag, e.g: `en_US_` or blank
+	ValidateLocales bool
+	// StrictLoad parse all translations when loading with `Reload` or `NewStrict`, return error if any of them has invalid syntax
+	StrictLoad bool
+	// Recorder record lookups of T, they could be retrieved with `RecordedLookups` to assert which keys are used in tests
+	Recorder bool
+	// IsolateArgs wrap string arguments interpolated into translations with Unicode isolates FSI and PDI, so mixed-direction values won't scramble the sentence
+	IsolateArgs bool
+	// ReplaceUnfilled replace placeholders referencing missing arguments with UnfilledToken before formatting, e.g: `{{$1}}` without arguments, or `%s` with Sprintf formatter
+	ReplaceUnfilled bool
+	// UnfilledToken token used to replace unfilled placeholders, default to blank
+	UnfilledToken string
+	// OnParseError is called when failed to parse translation with CLDR, or arguments mismatched placeholders in debug mode
+	OnParseError func(locale, key, value string, err error)
+
+	cachedLocales    []string
+	cacheKeyFunc     func(locale, key string) string
+	retryAttempts    int
+	retryBase        time.Duration
+	fallbackFunc     func(locale string) []string
+	logger           Logger
+	missing          MissingBehavior
+	missingHandler   func(locale, key string) string
+	environment      string
+	currentUserFunc  func(*http.Request) qor.CurrentUser
+	defaultLocale    string
+	cldrProvider     CLDRProvider
+	inlineSeparator  string
+	variantSeparator string
+	inlineEditAssets InlineEditAssetOptions
+	formatter        Formatter
+	maxPlaceholders  int
+
+	autoCreateBackend Backend
+	aliases           *keyAliases
+	frozen            *frozenLocales
+	defaultWarning    *sync.Once
+	recorder          *lookupRecorder
+	autoCreates       *autoCreateQueue
+	cached            *cacheIndex
+	lazyLocales       *lazyLocales
 }
 
 // ResourceName change display name in qor admin
@@ -41,17 +108,41 @@ type Backend interface {
 	DeleteTranslation(*Translation) error
 }
 
+// ErrorReportingBackend is an optional interface for backends that could report errors when loading translations
+// Translations returned with an error will still be used, and the error will be logged
+type ErrorReportingBackend interface {
+	LoadTranslationsE() ([]*Translation, error)
+}
+
+// Logger is used to log warnings and errors, it is compatible with *log.Logger
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
 // Translation is a struct for translations, including Translation Key, Locale, Value
 type Translation struct {
-	Key     string
-	Locale  string
-	Value   string
+	Key       string
+	Locale    string
+	Value     string
+	UpdatedAt time.Time
+	// Auto is true for translations created by T for missing keys, they are blank unless seeded with `SeedFromKey`
+	Auto bool
+	// Comment note for translators, it is loaded from backends support metadata like front-matter backend
+	Comment string
+	// Fuzzy is true if the translation needs review
+	Fuzzy   bool
 	Backend Backend `json:"-"`
 }
 
 // New initialize I18n with backends
+// Without backends, I18n works in cache only mode, translations are saved to the cache store only and lost after restart
 func New(backends ...Backend) *I18n {
-	i18n := &I18n{Backends: backends, cacheStore: memory.New()}
+	return NewWithLogger(nil, backends...)
+}
+
+// NewWithLogger initialize I18n with backends like `New`, logger is set before loading, so errors of loading backends are logged with it
+func NewWithLogger(logger Logger, backends ...Backend) *I18n {
+	i18n := &I18n{logger: logger, Backends: backends, cacheStore: memory.New(), aliases: &keyAliases{keys: map[string]string{}, warned: map[string]bool{}}, frozen: &frozenLocales{locales: map[string]bool{}}, defaultWarning: &sync.Once{}, recorder: &lookupRecorder{}, autoCreates: newAutoCreateQueue(), cached: &cacheIndex{keys: map[string]map[string]bool{}}}
 	i18n.loadToCacheStore()
 	return i18n
 }
@@ -59,17 +150,90 @@ func New(backends ...Backend) *I18n {
 // SetCacheStore set i18n's cache store
 func (i18n *I18n) SetCacheStore(cacheStore cache.CacheStoreInterface) {
 	i18n.cacheStore = cacheStore
+	i18n.cached.reset()
+	i18n.loadToCacheStore()
+}
+
+// SetLogger set logger for warnings and errors, they are logged with the standard logger of package log by default
+func (i18n *I18n) SetLogger(logger Logger) {
+	i18n.logger = logger
+}
+
+func (i18n *I18n) logf(format string, v ...interface{}) {
+	if i18n.logger != nil {
+		i18n.logger.Printf(format, v...)
+	} else {
+		log.Printf(format, v...)
+	}
+}
+
+// SetCacheKeyFunc set func used to generate cache key for translations, e.g: prefix keys with tenant to share a cache store
+func (i18n *I18n) SetCacheKeyFunc(fc func(locale, key string) string) {
+	i18n.cacheKeyFunc = fc
+	i18n.loadToCacheStore()
+}
+
+func (i18n *I18n) cacheKeyFor(locale, key string) string {
+	if i18n.NormalizeUnicode {
+		key = norm.NFC.String(key)
+	}
+
+	if i18n.cacheKeyFunc != nil {
+		return i18n.cacheKeyFunc(locale, key)
+	}
+	return cacheKey(locale, key)
+}
+
+// LoadTranslationsFor only cache translations of given locales, translations of other locales will be evicted from the cache store
+// It could be used with locales that current user could view to reduce memory usage, e.g: `I18n.LoadTranslationsFor(user.ViewableLocales()...)`
+func (i18n *I18n) LoadTranslationsFor(locales ...string) {
+	i18n.cachedLocales = locales
 	i18n.loadToCacheStore()
 }
 
 func (i18n *I18n) loadToCacheStore() {
+	i18n.lazyLocales = &lazyLocales{onces: map[string]*sync.Once{}, pending: map[int]map[string][]*Translation{}}
+	if i18n.LazyPerLocale {
+		i18n.loadLocaleLazily(i18n.getDefaultLocale())
+		return
+	}
+
 	backends := i18n.Backends
 	for i := len(backends) - 1; i >= 0; i-- {
 		var backend = backends[i]
-		for _, translation := range backend.LoadTranslations() {
-			i18n.AddTranslation(translation)
+		for _, translation := range i18n.loadBackendTranslations(backend) {
+			if i18n.isCachedLocale(translation.Locale) {
+				i18n.AddTranslation(translation)
+			} else {
+				i18n.deleteCached(translation.Locale, translation.Key)
+			}
+		}
+	}
+}
+
+func (i18n *I18n) isCachedLocale(locale string) bool {
+	if len(i18n.cachedLocales) == 0 {
+		return true
+	}
+
+	for _, l := range i18n.cachedLocales {
+		if l == locale {
+			return true
 		}
 	}
+	return false
+}
+
+// loadBackendTranslations load translations from backend, errors reported by backend will be logged
+func (i18n *I18n) loadBackendTranslations(backend Backend) []*Translation {
+	if errorReportingBackend, ok := backend.(ErrorReportingBackend); ok {
+		translations, err := errorReportingBackend.LoadTranslationsE()
+		if err != nil {
+			i18n.logf("Failed to load translations from backend %T, got: %v", backend, err)
+		}
+		return translations
+	}
+	return backend.LoadTranslations()
 }
 
 // LoadTranslations load translations as map `map[locale]map[key]*Translation`
@@ -78,7 +242,7 @@ func (i18n *I18n) LoadTranslations() map[string]map[string]*Translation {
 
 	for i := len(i18n.Backends); i > 0; i-- {
 		backend := i18n.Backends[i-1]
-		for _, translation := range backend.LoadTranslations() {
+		for _, translation := range i18n.loadBackendTranslations(backend) {
 			if translations[translation.Locale] == nil {
 				translations[translation.Locale] = map[string]*Translation{}
 			}
@@ -88,16 +252,161 @@ func (i18n *I18n) LoadTranslations() map[string]map[string]*Translation {
 	return translations
 }
 
+// LoadFromBackend load translations of backend ordered by locale then key, if the backend has multiple translations with same locale and key, the last one is used
+func (i18n *I18n) LoadFromBackend(backend Backend) []*Translation {
+	var translations = map[string]map[string]*Translation{}
+	for _, translation := range i18n.loadBackendTranslations(backend) {
+		if translations[translation.Locale] == nil {
+			translations[translation.Locale] = map[string]*Translation{}
+		}
+		translations[translation.Locale][translation.Key] = translation
+	}
+	return sortTranslations(translations)
+}
+
+// LoadTranslationsSorted load translations as a slice ordered by locale then key, it gives deterministic output for diffing and tests
+func (i18n *I18n) LoadTranslationsSorted() []*Translation {
+	return sortTranslations(i18n.LoadTranslations())
+}
+
+// Each iterate all translations ordered by locale then key, stop iterating if fc returns false
+// Translations with same locale and key from multiple backends will be yielded once with the one has higher priority
+func (i18n *I18n) Each(fc func(*Translation) bool) {
+	for _, translation := range i18n.LoadTranslationsSorted() {
+		if !fc(translation) {
+			return
+		}
+	}
+}
+
+func sortTranslations(translations map[string]map[string]*Translation) []*Translation {
+	var locales []string
+	for locale := range translations {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+
+	var results []*Translation
+	for _, locale := range locales {
+		var keys []string
+		for key := range translations[locale] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			results = append(results, translations[locale][key])
+		}
+	}
+	return results
+}
+
+// availableLocales return locales that have translations in backends
+func (i18n *I18n) availableLocales() []string {
+	var locales []string
+	for locale := range i18n.LoadTranslations() {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// AllLocales return values of key for all locales that have translations, value will be blank if the key is missing in a locale
+func (i18n *I18n) AllLocales(key string) map[string]string {
+	values := map[string]string{}
+	for _, locale := range i18n.availableLocales() {
+		var translation Translation
+		i18n.cacheStore.Unmarshal(i18n.cacheKeyFor(locale, key), &translation)
+		values[locale] = translation.Value
+	}
+	return values
+}
+
+// Placeholders return translations auto-created by T for missing keys ordered by locale and key, they are referenced but haven't been translated
+func (i18n *I18n) Placeholders() []*Translation {
+	var placeholders []*Translation
+	for _, translation := range sortTranslations(i18n.LoadTranslations()) {
+		if translation.Auto {
+			placeholders = append(placeholders, translation)
+		}
+	}
+	return placeholders
+}
+
+// BackendStat translations count of a backend
+type BackendStat struct {
+	Backend Backend
+	Count   int
+}
+
+// BackendStats return translations count of each backend, in the same order as `Backends`
+func (i18n *I18n) BackendStats() []BackendStat {
+	var stats []BackendStat
+	for _, backend := range i18n.Backends {
+		stats = append(stats, BackendStat{Backend: backend, Count: len(i18n.loadBackendTranslations(backend))})
+	}
+	return stats
+}
+
 // AddTranslation add translation
 func (i18n *I18n) AddTranslation(translation *Translation) error {
-	return i18n.cacheStore.Set(cacheKey(translation.Locale, translation.Key), translation)
+	i18n.normalizeTranslation(translation)
+	i18n.cached.add(translation.Locale, translation.Key)
+	return i18n.cacheStore.Set(i18n.cacheKeyFor(translation.Locale, translation.Key), translation)
 }
 
-// SaveTranslation save translation
+// maxRetryDuration max total time to wait between retries of a backend operation
+const maxRetryDuration = 10 * time.Second
+
+// SetRetryPolicy retry failed backend saves and deletes up to attempts times, the wait time starts from base and doubles for each retry
+func (i18n *I18n) SetRetryPolicy(attempts int, base time.Duration) {
+	i18n.retryAttempts = attempts
+	i18n.retryBase = base
+}
+
+func (i18n *I18n) retry(fc func() error) (err error) {
+	var (
+		wait   = i18n.retryBase
+		waited time.Duration
+	)
+
+	for attempt := 1; ; attempt++ {
+		if err = fc(); err == nil || attempt >= i18n.retryAttempts || waited+wait > maxRetryDuration {
+			return err
+		}
+
+		time.Sleep(wait)
+		waited += wait
+		wait *= 2
+	}
+}
+
+func (i18n *I18n) normalizeTranslation(translation *Translation) {
+	if i18n.NormalizeWhitespace {
+		translation.Value = strings.Join(strings.Fields(translation.Value), " ")
+	}
+
+	if i18n.NormalizeUnicode {
+		translation.Key = norm.NFC.String(translation.Key)
+	}
+
+	if i18n.NormalizeUnicodeValues {
+		translation.Value = norm.NFC.String(translation.Value)
+	}
+}
+
+// SaveTranslation save translation, it will be saved to cache store only if there is no backend
 func (i18n *I18n) SaveTranslation(translation *Translation) error {
+	if err := i18n.prepareTranslation(translation); err != nil {
+		return err
+	}
+
+	if len(i18n.Backends) == 0 {
+		return i18n.AddTranslation(translation)
+	}
+
 	for _, backend := range i18n.Backends {
-		if backend.SaveTranslation(translation) == nil {
-			i18n.AddTranslation(translation)
+		if i18n.saveToBackend(backend, translation) == nil {
 			return nil
 		}
 	}
@@ -105,111 +414,329 @@ func (i18n *I18n) SaveTranslation(translation *Translation) error {
 	return errors.New("failed to save translation")
 }
 
+// prepareTranslation validate and normalize translation before saving it
+func (i18n *I18n) prepareTranslation(translation *Translation) error {
+	if i18n.ValidateLocales {
+		if err := ValidateLocale(translation.Locale); err != nil {
+			return err
+		}
+	}
+
+	i18n.normalizeTranslation(translation)
+	if translation.UpdatedAt.IsZero() {
+		translation.UpdatedAt = time.Now()
+	}
+	return nil
+}
+
+// saveToBackend save translation into backend, record history if its value changed, then update cache store
+func (i18n *I18n) saveToBackend(backend Backend, translation *Translation) error {
+	var previous Translation
+	hasPrevious := i18n.cacheStore.Unmarshal(i18n.cacheKeyFor(translation.Locale, translation.Key), &previous) == nil
+
+	if err := i18n.retry(func() error { return backend.SaveTranslation(translation) }); err != nil {
+		return err
+	}
+
+	if hasPrevious && previous.Value != translation.Value {
+		recordHistory(backend, translation, previous.Value)
+	}
+	i18n.AddTranslation(translation)
+	return nil
+}
+
 // DeleteTranslation delete translation
 func (i18n *I18n) DeleteTranslation(translation *Translation) (err error) {
 	for _, backend := range i18n.Backends {
-		backend.DeleteTranslation(translation)
+		i18n.retry(func() error { return backend.DeleteTranslation(translation) })
+	}
+
+	return i18n.deleteCached(translation.Locale, translation.Key)
+}
+
+// SetAutoCreateBackend set backend to save translations created by T for missing keys, the first backend accepts it will be used by default
+func (i18n *I18n) SetAutoCreateBackend(backend Backend) {
+	i18n.autoCreateBackend = backend
+}
+
+// autoCreate save translation created for a missing key, it is cached even if saving failed, so T won't retry saving it on every lookup
+// Translations rejected by validation are neither saved nor cached
+func (i18n *I18n) autoCreate(translation *Translation) {
+	if err := i18n.prepareTranslation(translation); err != nil {
+		return
 	}
 
-	return i18n.cacheStore.Delete(cacheKey(translation.Locale, translation.Key))
+	var err error
+	if i18n.autoCreateBackend == nil {
+		if len(i18n.Backends) > 0 {
+			translation.Backend = i18n.Backends[0]
+		}
+		err = i18n.SaveTranslation(translation)
+	} else {
+		translation.Backend = i18n.autoCreateBackend
+		err = i18n.saveToBackend(i18n.autoCreateBackend, translation)
+	}
+
+	if err != nil {
+		i18n.AddTranslation(translation)
+	}
+}
+
+// MissingBehavior decides what T returns for missing translations
+type MissingBehavior int
+
+const (
+	// ReturnKey return the default value set with `Default` or the key, it is the default behavior
+	ReturnKey MissingBehavior = iota
+	// ReturnEmpty always return blank string, the default value set with `Default` won't be used
+	ReturnEmpty
+	// ReturnDefault return the default value set with `Default`, or blank string if there is no default value
+	ReturnDefault
+)
+
+// SetMissingBehavior set what T returns for missing translations
+func (i18n *I18n) SetMissingBehavior(behavior MissingBehavior) {
+	i18n.missing = behavior
+}
+
+// Default return a copy of I18n that uses value as default value of missing translations
+func (i18n *I18n) Default(value string) *I18n {
+	clone := i18n.clone()
+	clone.value = value
+	return clone
+}
+
+// Fallbacks return a copy of I18n that falls back to locales before configured fallback locales
+func (i18n *I18n) Fallbacks(locale ...string) *I18n {
+	clone := i18n.clone()
+	clone.fallbackLocales = locale
+	return clone
+}
+
+// TWithFallback translate with locale, key and arguments, fall back to given locales instead of configured fallback locales for this call
+// Default locale is still the last fallback
+func (i18n *I18n) TWithFallback(locale string, fallbacks []string, key string, args ...interface{}) template.HTML {
+	clone := i18n.clone()
+	clone.fallbackLocales = fallbacks
+	clone.FallbackLocales = nil
+	clone.fallbackFunc = nil
+	return clone.T(locale, key, args...)
+}
+
+func (i18n *I18n) clone() *I18n {
+	clone := *i18n
+	return &clone
 }
 
 // T translate with locale, key and arguments
 func (i18n *I18n) T(locale, key string, args ...interface{}) template.HTML {
-	var (
-		value           = i18n.value
-		translationKey  = key
-		fallbackLocales = i18n.fallbackLocales
-	)
+	key = i18n.resolveKeyAlias(key)
+	translationKey := key
 
 	if locale == "" {
-		locale = Default
+		locale = i18n.getDefaultLocale()
+		i18n.warnPackageDefault()
 	}
 
-	if locales, ok := i18n.FallbackLocales[locale]; ok {
-		fallbackLocales = append(fallbackLocales, locales...)
-	}
-	fallbackLocales = append(fallbackLocales, Default)
-
 	if i18n.scope != "" {
-		translationKey = strings.Join([]string{i18n.scope, key}, ".")
+		translationKey = joinKey(i18n.scope, key)
 	}
+	i18n.recordLookup(locale, translationKey, args)
 
-	var translation Translation
-	if err := i18n.cacheStore.Unmarshal(cacheKey(locale, key), &translation); err != nil || translation.Value == "" {
-		for _, fallbackLocale := range fallbackLocales {
-			if err := i18n.cacheStore.Unmarshal(cacheKey(fallbackLocale, key), &translation); err == nil && translation.Value != "" {
-				break
+	translation, found := i18n.findEnvironmentTranslation(locale, translationKey)
+	if !found {
+		if handled, ok := i18n.handleMissing(locale, translationKey); ok {
+			translation = Translation{Key: translationKey, Value: handled, Locale: locale}
+		}
+	}
+	if !found && translation.Value == "" {
+		translation = Translation{Key: translationKey, Value: i18n.autoCreatedValue(key), Locale: locale, Auto: true}
+		if i18n.canAutoCreate(locale) {
+			if i18n.AsyncAutoCreate {
+				// copy the translation, it is modified by auto create in background
+				created := translation
+				i18n.autoCreateAsync(&created)
+			} else {
+				i18n.autoCreate(&translation)
 			}
 		}
+	}
 
-		if translation.Value == "" {
-			// Get default translation if not translated
-			if err := i18n.cacheStore.Unmarshal(cacheKey(Default, key), &translation); err != nil || translation.Value == "" {
-				// If not initialized
-				var defaultBackend Backend
-				if len(i18n.Backends) > 0 {
-					defaultBackend = i18n.Backends[0]
-				}
-				translation = Translation{Key: translationKey, Value: value, Locale: locale, Backend: defaultBackend}
-
-				// Save translation
-				i18n.SaveTranslation(&translation)
-			}
+	value := i18n.returnedValue(key, translation.Value)
+	result := i18n.render(locale, key, value, args)
+	if i18n.MarkUntranslated && result != "" && (!found || translation.Auto || translation.Locale != locale) {
+		result = `<span class="i18n-missing">` + result + `</span>`
+	}
+	return template.HTML(result)
+}
+
+// canAutoCreate check if missing translations of locale could be auto created
+// Translations of locales excluded by `LoadTranslationsFor` aren't cached, auto creating them would overwrite values in backends
+func (i18n *I18n) canAutoCreate(locale string) bool {
+	return !i18n.IsFrozen(locale) && i18n.isCachedLocale(locale)
+}
+
+// autoCreatedValue return value of translation auto created for missing key, it is the default value set with `Default` unless `MissingBehavior` is ReturnEmpty
+func (i18n *I18n) autoCreatedValue(key string) string {
+	value := i18n.value
+	if i18n.missing == ReturnEmpty {
+		value = ""
+	}
+	if i18n.SeedFromKey && value == "" {
+		value = humanizeKey(key)
+	}
+	return value
+}
+
+// returnedValue return value T renders for translation value, blank value is replaced according to `MissingBehavior`
+func (i18n *I18n) returnedValue(key, value string) string {
+	if value != "" {
+		return value
+	} else if i18n.missing != ReturnKey {
+		return ""
+	} else if i18n.HumanizeMissing {
+		return humanizeKey(key)
+	}
+	return key
+}
+
+// render parse value with formatter (CLDR by default) and arguments, value will be returned as it is if failed to parse
+func (i18n *I18n) render(locale, key, value string, args []interface{}) string {
+	value = i18n.resolveReferences(locale, key, value, map[string]bool{key: true})
+	if i18n.IsolateArgs {
+		args = isolateArgs(args)
+	}
+
+	formatter := i18n.formatter
+	if formatter == nil {
+		formatter = CLDRFormatter
+	}
+	value, args = applyNamedArgs(formatter, value, args)
+
+	isCLDR := formatter == CLDRFormatter
+	if isCLDR {
+		formatter = FormatterFunc(i18n.getCLDRProvider().Parse)
+	}
+
+	if i18n.maxPlaceholders > 0 {
+		if count := countPlaceholders(value); count > i18n.maxPlaceholders {
+			i18n.logf("Translation %v of %v has %v placeholders, exceeds the max %v, it won't be formatted", key, locale, count, i18n.maxPlaceholders)
+			return value
 		}
 	}
 
-	if translation.Value != "" {
-		value = translation.Value
-	} else {
-		value = key
+	if i18n.Debug && isCLDR {
+		for _, err := range validateArgs(value, args) {
+			i18n.reportParseError(locale, key, value, err)
+		}
 	}
 
-	if str, err := cldr.Parse(locale, value, args...); err == nil {
-		value = str
+	pattern := value
+	if i18n.ReplaceUnfilled {
+		pattern = replaceUnfilledPlaceholders(value, args, isCLDR, i18n.UnfilledToken)
 	}
 
-	return template.HTML(value)
+	str, err := formatter.Format(locale, pattern, args...)
+	if err != nil {
+		i18n.reportParseError(locale, key, value, err)
+		str = value
+	}
+	return str
 }
 
-// RenderInlineEditAssets render inline edit html, it is using: http://vitalets.github.io/x-editable/index.html
-// You could use Bootstrap or JQuery UI by set isIncludeExtendAssetLib to false and load files by yourself
-func RenderInlineEditAssets(isIncludeJQuery bool, isIncludeExtendAssetLib bool) (template.HTML, error) {
-	for _, gopath := range utils.GOPATH() {
-		var content string
-		var hasError bool
+func (i18n *I18n) reportParseError(locale, key, value string, err error) {
+	if i18n.OnParseError != nil {
+		i18n.OnParseError(locale, key, value, err)
+	}
+}
 
-		if isIncludeJQuery {
-			content = `<script src="http://code.jquery.com/jquery-2.0.3.min.js"></script>`
+// fallbackLocalesFor return locales to look up in order if translation of locale is missing, duplicated locales and locale itself are removed from the chain
+func (i18n *I18n) fallbackLocalesFor(locale string) []string {
+	fallbackLocales := append([]string{}, i18n.fallbackLocales...)
+	if i18n.fallbackFunc != nil {
+		fallbackLocales = append(fallbackLocales, i18n.fallbackFunc(locale)...)
+	} else if locales, ok := i18n.FallbackLocales[locale]; ok {
+		fallbackLocales = append(fallbackLocales, locales...)
+	}
+	fallbackLocales = append(fallbackLocales, i18n.getDefaultLocale())
+
+	var (
+		chain   []string
+		visited = map[string]bool{locale: true}
+	)
+	for _, l := range fallbackLocales {
+		if !visited[l] {
+			visited[l] = true
+			chain = append(chain, l)
 		}
+	}
+	return chain
+}
 
-		if isIncludeExtendAssetLib {
-			if extendLib, err := ioutil.ReadFile(filepath.Join(gopath, "src/github.com/qor/i18n/views/themes/i18n/inline-edit-libs.tmpl")); err == nil {
-				content += string(extendLib)
-			} else {
-				hasError = true
-			}
+// SetFallbackFunc set func to compute fallback locales for each lookup, it replaces `FallbackLocales` when set
+func (i18n *I18n) SetFallbackFunc(fc func(locale string) []string) {
+	i18n.fallbackFunc = fc
+}
 
-			if css, err := ioutil.ReadFile(filepath.Join(gopath, "src/github.com/qor/i18n/views/themes/i18n/assets/stylesheets/i18n-inline.css")); err == nil {
-				content += fmt.Sprintf("<style>%s</style>", string(css))
-			} else {
-				hasError = true
-			}
+// findTranslation find translation with value from cache store for locale and its fallback locales, it won't create missing translations
+func (i18n *I18n) findTranslation(locale, key string) (Translation, bool) {
+	// fast path for the most common case, there is nothing to fall back to for default locale
+	if locale == i18n.getDefaultLocale() && i18n.scope == "" && !i18n.hasFallbacks(locale) {
+		return i18n.lookupTranslation(locale, key)
+	}
 
+	for _, l := range append([]string{locale}, i18n.fallbackLocalesFor(locale)...) {
+		if translation, ok := i18n.lookupTranslation(l, key); ok {
+			return translation, true
 		}
+	}
+	return Translation{}, false
+}
 
-		if js, err := ioutil.ReadFile(filepath.Join(gopath, "src/github.com/qor/i18n/views/themes/i18n/assets/javascripts/i18n-inline.js")); err == nil {
-			content += fmt.Sprintf("<script type=\"text/javascript\">%s</script>", string(js))
-		} else {
-			hasError = true
+// lookupTranslation look up translation with value from cache store for the exact locale
+func (i18n *I18n) lookupTranslation(locale, key string) (Translation, bool) {
+	i18n.loadLocaleLazily(locale)
+
+	var translation Translation
+	if err := i18n.cacheStore.Unmarshal(i18n.cacheKeyFor(locale, key), &translation); err == nil {
+		if translation.Value != "" {
+			return translation, true
 		}
+		return Translation{}, false
+	}
+	return i18n.reloadEvicted(locale, key)
+}
+
+func (i18n *I18n) hasFallbacks(locale string) bool {
+	if len(i18n.fallbackLocales) > 0 || i18n.fallbackFunc != nil {
+		return true
+	}
+	_, ok := i18n.FallbackLocales[locale]
+	return ok
+}
 
-		if !hasError {
-			return template.HTML(content), nil
+// ValidateKeys return keys that don't have translation for locale, including its fallback locales
+// It could be used in CI to make sure all keys used in templates have been translated for the default locale
+func (i18n *I18n) ValidateKeys(keys []string, locale string) []string {
+	var missingKeys []string
+	for _, key := range keys {
+		if _, found := i18n.findTranslation(locale, key); !found {
+			missingKeys = append(missingKeys, key)
 		}
 	}
+	return missingKeys
+}
 
-	return template.HTML(""), errors.New("templates not found")
+// TJSON translate with locale, key and arguments, returns a plain string for API responses
+// The value isn't HTML escaped, so encode it with encoding/json instead of wrapping it in template.HTML
+func (i18n *I18n) TJSON(locale, key string, args ...interface{}) string {
+	return string(i18n.T(locale, key, args...))
+}
+
+// RenderInlineEditAssets render inline edit html, it is using: http://vitalets.github.io/x-editable/index.html
+// You could use Bootstrap or JQuery UI by set isIncludeExtendAssetLib to false and load files by yourself
+func RenderInlineEditAssets(isIncludeJQuery bool, isIncludeExtendAssetLib bool) (template.HTML, error) {
+	return RenderInlineEditAssetsWithOptions(InlineEditAssetOptions{IncludeJQuery: isIncludeJQuery, IncludeExtendAssetLib: isIncludeExtendAssetLib})
 }
 
 func getLocaleFromContext(context *qor.Context) string {
@@ -254,6 +781,53 @@ func getEditableLocales(req *http.Request, currentUser qor.CurrentUser) []string
 	return []string{Default}
 }
 
+var namedArgRegexp = regexp.MustCompile(`{{\s*\.(\w+)\s*}}`)
+
+// applyNamedArgs if the last one of multiple args is a `map[string]interface{}`, fill its values into `{{.Name}}` placeholders,
+// and return the remaining positional args for formatter, e.g: `T("en-US", "key", "positional", map[string]interface{}{"Name": "Jinzhu"})`
+// Values are escaped for the built-in formatter, so they won't be parsed as patterns
+func applyNamedArgs(formatter Formatter, value string, args []interface{}) (string, []interface{}) {
+	if len(args) < 2 {
+		return value, args
+	}
+
+	named, ok := args[len(args)-1].(map[string]interface{})
+	if !ok {
+		return value, args
+	}
+
+	escaper := strings.NewReplacer()
+	switch formatter {
+	case CLDRFormatter:
+		escaper = strings.NewReplacer("{{", `{{"{{"}}`)
+	case SprintfFormatter:
+		escaper = strings.NewReplacer("%", "%%")
+	}
+
+	value = namedArgRegexp.ReplaceAllStringFunc(value, func(placeholder string) string {
+		if v, ok := named[namedArgRegexp.FindStringSubmatch(placeholder)[1]]; ok {
+			return escaper.Replace(fmt.Sprint(v))
+		}
+		return placeholder
+	})
+
+	return value, args[:len(args)-1]
+}
+
+// humanizeKey turns the last segment of a translation key into a readable text, e.g: `home.welcome_message` => `Welcome message`
+func humanizeKey(key string) string {
+	segments := SplitKey(key)
+	key = segments[len(segments)-1]
+
+	key = strings.TrimSpace(strings.NewReplacer("_", " ", "-", " ").Replace(key))
+	if key == "" {
+		return key
+	}
+
+	r, size := utf8.DecodeRuneInString(key)
+	return string(unicode.ToUpper(r)) + key[size:]
+}
+
 func cacheKey(strs ...string) string {
 	return strings.Join(strs, "/")
 }
@@ -1,8 +1,17 @@
 package i18n
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"html/template"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/qor/cache"
+	"github.com/qor/cache/memory"
 )
 
 type backend struct{}
@@ -11,6 +20,30 @@ func (b *backend) LoadTranslations() (translations []*Translation) { return tran
 func (b *backend) SaveTranslation(t *Translation) error            { return nil }
 func (b *backend) DeleteTranslation(t *Translation) error          { return nil }
 
+type sliceBackend struct {
+	translations []*Translation
+}
+
+func (b *sliceBackend) LoadTranslations() []*Translation { return b.translations }
+func (b *sliceBackend) SaveTranslation(t *Translation) error {
+	b.translations = append(b.translations, t)
+	return nil
+}
+func (b *sliceBackend) DeleteTranslation(t *Translation) error { return nil }
+
+type flakyBackend struct {
+	backend
+	failures int
+	calls    int
+}
+
+func (b *flakyBackend) SaveTranslation(t *Translation) error {
+	if b.calls++; b.calls <= b.failures {
+		return errors.New("temporary failure")
+	}
+	return nil
+}
+
 const BIGNUM = 10000
 
 // run TestConcurrent* tests with -race flag would be better
@@ -51,3 +84,708 @@ func TestFallbackLocale(t *testing.T) {
 		t.Errorf("Haven't setup any fallback")
 	}
 }
+
+func TestTJSON(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "tom-and-jerry", Locale: "en-US", Value: `<b>Tom & Jerry</b> "cartoon"`})
+
+	if value := i18n.TJSON("en-US", "tom-and-jerry"); value != `<b>Tom & Jerry</b> "cartoon"` {
+		t.Errorf("TJSON should return raw value, but got %v", value)
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(map[string]string{"title": i18n.TJSON("en-US", "tom-and-jerry")}); err != nil {
+		t.Fatalf("failed to encode json, got %v", err)
+	}
+
+	if strings.Contains(buf.String(), "&amp;") || strings.Contains(buf.String(), "&lt;") {
+		t.Errorf("TJSON value shouldn't be HTML entity encoded, but got %v", buf.String())
+	}
+
+	var result map[string]string
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil || result["title"] != `<b>Tom & Jerry</b> "cartoon"` {
+		t.Errorf("TJSON value should round trip through JSON, but got %v", result["title"])
+	}
+}
+
+func TestHumanizeMissing(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.HumanizeMissing = true
+
+	for key, result := range map[string]string{
+		"home.welcome_message": "Welcome message",
+		"title":                "Title",
+		"user.first-name":      "First name",
+		"admin.menu.log_out":   "Log out",
+		"brand.élan_vital":     "Élan vital",
+		"greeting.привет":      "Привет",
+	} {
+		if value := i18n.T("en-US", key); string(value) != result {
+			t.Errorf("missing key %v should be humanized as %v, but got %v", key, result, value)
+		}
+	}
+
+	i18n.HumanizeMissing = false
+	if value := i18n.T("en-US", "home.welcome_message"); value != "home.welcome_message" {
+		t.Errorf("missing key should be returned as it is if HumanizeMissing not enabled, but got %v", value)
+	}
+}
+
+func TestBackendStats(t *testing.T) {
+	fileBackend := &sliceBackend{translations: []*Translation{
+		{Key: "hello", Locale: "en-US", Value: "Hello"},
+		{Key: "hello", Locale: "zh-CN", Value: "你好"},
+		{Key: "bye", Locale: "en-US", Value: "Bye"},
+	}}
+	dbBackend := &sliceBackend{translations: []*Translation{{Key: "title", Locale: "en-US", Value: "Title"}}}
+	i18n := New(dbBackend, fileBackend, &backend{})
+
+	stats := i18n.BackendStats()
+	if len(stats) != 3 {
+		t.Fatalf("should return stats for all backends, but got %v", len(stats))
+	}
+
+	for idx, count := range []int{1, 3, 0} {
+		if stats[idx].Backend != i18n.Backends[idx] || stats[idx].Count != count {
+			t.Errorf("backend #%v should have %v translations, but got %v", idx, count, stats[idx].Count)
+		}
+	}
+}
+
+func TestMixedNamedAndPositionalArgs(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "greeting", Locale: "en-US", Value: "Hi {{.Name}}, you have {{$1}} messages from {{$2}}"})
+
+	result := i18n.T("en-US", "greeting", 3, "Jinzhu", map[string]interface{}{"Name": "Tom"})
+	if result != "Hi Tom, you have 3 messages from Jinzhu" {
+		t.Errorf("should apply both named and positional args, but got %v", result)
+	}
+
+	result = i18n.T("en-US", "greeting", 3, "Jinzhu", map[string]interface{}{"Name": "{{.Secret}}"})
+	if result != "Hi {{.Secret}}, you have 3 messages from Jinzhu" {
+		t.Errorf("named args shouldn't be parsed as template, but got %v", result)
+	}
+
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello {{.Name}}"})
+	if result := i18n.T("en-US", "hello", map[string]interface{}{"Name": "Tom"}); result != "Hello Tom" {
+		t.Errorf("single map arg should still be used as template data, but got %v", result)
+	}
+
+	i18n.SetFormatter(SprintfFormatter)
+	i18n.AddTranslation(&Translation{Key: "sprintf", Locale: "en-US", Value: "Hi {{.Name}}, %d messages, {{.Rate}} read"})
+	if result := i18n.T("en-US", "sprintf", 3, map[string]interface{}{"Name": "{{Tom}}", "Rate": "50%"}); result != "Hi {{Tom}}, 3 messages, 50% read" {
+		t.Errorf("named args should be escaped for sprintf formatter, but got %v", result)
+	}
+}
+
+func TestLoadTranslationsFor(t *testing.T) {
+	i18n := New(&sliceBackend{translations: []*Translation{
+		{Key: "hello", Locale: "en-US", Value: "Hello"},
+		{Key: "hello", Locale: "zh-CN", Value: "你好"},
+		{Key: "hello", Locale: "de-DE", Value: "Hallo"},
+	}})
+
+	i18n.LoadTranslationsFor("en-US", "zh-CN")
+
+	var translation Translation
+	for _, locale := range []string{"en-US", "zh-CN"} {
+		if err := i18n.cacheStore.Unmarshal(i18n.cacheKeyFor(locale, "hello"), &translation); err != nil {
+			t.Errorf("translation of requested locale %v should be cached", locale)
+		}
+	}
+
+	if err := i18n.cacheStore.Unmarshal(i18n.cacheKeyFor("de-DE", "hello"), &translation); err == nil {
+		t.Errorf("translation of unrequested locale shouldn't be cached")
+	}
+}
+
+func TestLoadTranslationsForSkipAutoCreate(t *testing.T) {
+	backend := &sliceBackend{translations: []*Translation{
+		{Key: "hello", Locale: "en-US", Value: "Hello"},
+		{Key: "farewell", Locale: "de-DE", Value: "Auf Wiedersehen"},
+	}}
+	i18n := New(backend)
+	i18n.LoadTranslationsFor("en-US")
+
+	i18n.T("de-DE", "farewell")
+	if len(backend.translations) != 2 || backend.translations[1].Value != "Auf Wiedersehen" {
+		t.Errorf("translations of uncached locales shouldn't be auto created, got %v", backend.translations)
+	}
+
+	i18n.T("en-US", "farewell")
+	if len(backend.translations) != 3 || backend.translations[2].Locale != "en-US" {
+		t.Errorf("translations of cached locales should be auto created, got %v", backend.translations)
+	}
+}
+
+func TestSetCacheKeyFunc(t *testing.T) {
+	i18n := New(&sliceBackend{translations: []*Translation{{Key: "hello", Locale: "en-US", Value: "Hello"}}})
+	i18n.SetCacheKeyFunc(func(locale, key string) string {
+		return "tenant-a/" + locale + "/" + key
+	})
+
+	var translation Translation
+	if err := i18n.cacheStore.Unmarshal("tenant-a/en-US/hello", &translation); err != nil || translation.Value != "Hello" {
+		t.Errorf("translation should be cached with custom cache key")
+	}
+
+	i18n.AddTranslation(&Translation{Key: "bye", Locale: "en-US", Value: "Bye"})
+	if err := i18n.cacheStore.Unmarshal("tenant-a/en-US/bye", &translation); err != nil || translation.Value != "Bye" {
+		t.Errorf("added translation should be cached with custom cache key")
+	}
+
+	if result := i18n.T("en-US", "bye"); result != "Bye" {
+		t.Errorf("should lookup translation with custom cache key, but got %v", result)
+	}
+
+	i18n.DeleteTranslation(&Translation{Key: "bye", Locale: "en-US"})
+	if err := i18n.cacheStore.Unmarshal("tenant-a/en-US/bye", &translation); err == nil {
+		t.Errorf("translation should be deleted with custom cache key")
+	}
+}
+
+func TestSetRetryPolicy(t *testing.T) {
+	flaky := &flakyBackend{failures: 2}
+	i18n := New(flaky)
+
+	if err := i18n.SaveTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello"}); err == nil {
+		t.Errorf("should fail without retry policy")
+	}
+
+	flaky.calls = 0
+	i18n.SetRetryPolicy(3, time.Millisecond)
+	if err := i18n.SaveTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello"}); err != nil {
+		t.Errorf("should succeed after retries, but got %v", err)
+	}
+
+	if flaky.calls != 3 {
+		t.Errorf("should call backend 3 times, but got %v", flaky.calls)
+	}
+
+	if result := i18n.T("en-US", "hello"); result != "Hello" {
+		t.Errorf("saved translation should be cached, but got %v", result)
+	}
+
+	flaky.calls, flaky.failures = 0, 5
+	if err := i18n.SaveTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello"}); err == nil {
+		t.Errorf("should fail after all attempts used")
+	}
+
+	if flaky.calls != 3 {
+		t.Errorf("should stop after 3 attempts, but got %v", flaky.calls)
+	}
+}
+
+func TestNormalizeWhitespace(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "code", Locale: "en-US", Value: "  a    b "})
+	if result := i18n.T("en-US", "code"); result != "  a    b " {
+		t.Errorf("whitespaces should be kept if NormalizeWhitespace not enabled, but got %q", result)
+	}
+
+	i18n.NormalizeWhitespace = true
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "  Hello \t  World\n "})
+	if result := i18n.T("en-US", "hello"); result != "Hello World" {
+		t.Errorf("whitespaces should be trimmed and collapsed when adding translation, but got %q", result)
+	}
+
+	i18n.SaveTranslation(&Translation{Key: "bye", Locale: "en-US", Value: "Good   bye "})
+	if result := i18n.T("en-US", "bye"); result != "Good bye" {
+		t.Errorf("whitespaces should be trimmed and collapsed when saving translation, but got %q", result)
+	}
+}
+
+func TestEach(t *testing.T) {
+	i18n := New(&sliceBackend{translations: []*Translation{
+		{Key: "hello", Locale: "zh-CN", Value: "你好"},
+		{Key: "hello", Locale: "en-US", Value: "Hello"},
+		{Key: "bye", Locale: "en-US", Value: "Bye"},
+		{Key: "bye", Locale: "zh-CN", Value: "再见"},
+	}})
+
+	var results []string
+	i18n.Each(func(translation *Translation) bool {
+		results = append(results, translation.Locale+":"+translation.Key)
+		return true
+	})
+
+	if strings.Join(results, ",") != "en-US:bye,en-US:hello,zh-CN:bye,zh-CN:hello" {
+		t.Errorf("should iterate translations ordered by locale and key, but got %v", results)
+	}
+
+	results = nil
+	i18n.Each(func(translation *Translation) bool {
+		results = append(results, translation.Locale+":"+translation.Key)
+		return len(results) < 2
+	})
+
+	if strings.Join(results, ",") != "en-US:bye,en-US:hello" {
+		t.Errorf("should stop iterating when func returns false, but got %v", results)
+	}
+}
+
+func TestValidateKeys(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.FallbackLocales = map[string][]string{"zh-CN": {"zh-TW"}}
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "zh-CN", Value: "你好"})
+	i18n.AddTranslation(&Translation{Key: "bye", Locale: "zh-TW", Value: "再見"})
+	i18n.AddTranslation(&Translation{Key: "title", Locale: "en-US", Value: "Title"})
+	i18n.AddTranslation(&Translation{Key: "blank", Locale: "zh-CN", Value: ""})
+
+	missingKeys := i18n.ValidateKeys([]string{"hello", "bye", "title", "blank", "missing"}, "zh-CN")
+	if strings.Join(missingKeys, ",") != "blank,missing" {
+		t.Errorf("should return keys without translation, but got %v", missingKeys)
+	}
+
+	if missingKeys := i18n.ValidateKeys([]string{"title"}, "en-US"); len(missingKeys) != 0 {
+		t.Errorf("should return no missing keys, but got %v", missingKeys)
+	}
+
+	if _, err := i18n.cacheStore.Get(i18n.cacheKeyFor("zh-CN", "missing")); err == nil {
+		t.Errorf("ValidateKeys shouldn't create missing translations")
+	}
+}
+
+func TestSetFallbackFunc(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.FallbackLocales = map[string][]string{"de-AT": {"fr-FR"}}
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "de", Value: "Hallo"})
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "fr-FR", Value: "Bonjour"})
+	i18n.AddTranslation(&Translation{Key: "bye", Locale: "en-US", Value: "Bye"})
+
+	i18n.SetFallbackFunc(func(locale string) []string {
+		if idx := strings.Index(locale, "-"); idx > 0 {
+			return []string{locale[:idx]}
+		}
+		return nil
+	})
+
+	if result := i18n.T("de-AT", "hello"); result != "Hallo" {
+		t.Errorf("should fallback to computed locale, but got %v", result)
+	}
+
+	if result := i18n.T("de-AT", "bye"); result != "Bye" {
+		t.Errorf("should still fallback to default locale, but got %v", result)
+	}
+}
+
+type countingCacheStore struct {
+	cache.CacheStoreInterface
+	reads map[string]int
+}
+
+func (store *countingCacheStore) Unmarshal(key string, object interface{}) error {
+	store.reads[key]++
+	return store.CacheStoreInterface.Unmarshal(key, object)
+}
+
+func TestCyclicFallbackLocales(t *testing.T) {
+	i18n := New(&backend{})
+	store := &countingCacheStore{CacheStoreInterface: memory.New(), reads: map[string]int{}}
+	i18n.SetCacheStore(store)
+	i18n.FallbackLocales = map[string][]string{"en-GB": {"en-AU", "en-GB"}, "en-AU": {"en-GB"}}
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello"})
+
+	if result := i18n.T("en-GB", "hello"); result != "Hello" {
+		t.Errorf("should fallback to default locale with cyclic fallbacks, but got %v", result)
+	}
+
+	for key, count := range store.reads {
+		if count > 1 {
+			t.Errorf("%v should be looked up once, but got %v", key, count)
+		}
+	}
+
+	i18n.SetFallbackFunc(func(locale string) []string {
+		return []string{"en-AU", locale, "en-AU", Default}
+	})
+	if result := i18n.T("en-AU", "hello"); result != "Hello" {
+		t.Errorf("should fallback to default locale with cyclic fallback func, but got %v", result)
+	}
+}
+
+func TestAllLocales(t *testing.T) {
+	i18n := New(&sliceBackend{translations: []*Translation{
+		{Key: "hello", Locale: "en-US", Value: "Hello"},
+		{Key: "hello", Locale: "zh-CN", Value: "你好"},
+		{Key: "bye", Locale: "de-DE", Value: "Tschüss"},
+	}})
+
+	values := i18n.AllLocales("hello")
+	if len(values) != 3 || values["en-US"] != "Hello" || values["zh-CN"] != "你好" {
+		t.Errorf("should return values of all locales, but got %v", values)
+	}
+
+	if value, ok := values["de-DE"]; !ok || value != "" {
+		t.Errorf("should return blank value for locales missing the key, but got %v", values)
+	}
+}
+
+type failingBackend struct {
+	sliceBackend
+}
+
+func (b *failingBackend) LoadTranslationsE() ([]*Translation, error) {
+	return b.translations, errors.New("connection lost")
+}
+
+type testLogger struct {
+	messages []string
+}
+
+func (logger *testLogger) Printf(format string, v ...interface{}) {
+	logger.messages = append(logger.messages, fmt.Sprintf(format, v...))
+}
+
+func TestLoadTranslationsWithError(t *testing.T) {
+	i18n := New()
+	logger := &testLogger{}
+	i18n.SetLogger(logger)
+	i18n.Backends = []Backend{&failingBackend{sliceBackend{translations: []*Translation{{Key: "hello", Locale: "en-US", Value: "Hello"}}}}}
+	i18n.SetCacheStore(memory.New())
+
+	if len(logger.messages) != 1 || !strings.Contains(logger.messages[0], "connection lost") {
+		t.Errorf("load error should be logged, but got %v", logger.messages)
+	}
+
+	if result := i18n.T("en-US", "hello"); result != "Hello" {
+		t.Errorf("partially loaded translations should still be used, but got %v", result)
+	}
+}
+
+func TestNewWithLogger(t *testing.T) {
+	logger := &testLogger{}
+	i18n := NewWithLogger(logger, &failingBackend{sliceBackend{translations: []*Translation{{Key: "hello", Locale: "en-US", Value: "Hello"}}}})
+
+	if len(logger.messages) != 1 || !strings.Contains(logger.messages[0], "connection lost") {
+		t.Errorf("load error of New should be logged with logger, but got %v", logger.messages)
+	}
+
+	if result := i18n.T("en-US", "hello"); result != "Hello" {
+		t.Errorf("translations should be loaded, but got %v", result)
+	}
+}
+
+func TestNormalizeUnicode(t *testing.T) {
+	var (
+		composed   = "caf\u00e9"
+		decomposed = "cafe\u0301"
+	)
+
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: decomposed, Locale: "fr-FR", Value: "Café"})
+	if result := i18n.T("fr-FR", composed); result == "Café" {
+		t.Errorf("composed key shouldn't match decomposed key if NormalizeUnicode not enabled")
+	}
+
+	i18n.NormalizeUnicode = true
+	i18n.NormalizeUnicodeValues = true
+	i18n.SaveTranslation(&Translation{Key: decomposed, Locale: "fr-FR", Value: "Bon " + decomposed})
+	if result := i18n.T("fr-FR", composed); result != template.HTML("Bon "+composed) {
+		t.Errorf("composed key should match decomposed key and value should be normalized, but got %q", result)
+	}
+
+	i18n.DeleteTranslation(&Translation{Key: composed, Locale: "fr-FR"})
+	if _, found := i18n.findTranslation("fr-FR", decomposed); found {
+		t.Errorf("translation should be deleted with composed key")
+	}
+}
+
+func TestDefaultLocaleFastPath(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: Default, Value: "Hello {{$1}}"})
+
+	if result := i18n.T(Default, "hello", "Jinzhu"); result != "Hello Jinzhu" {
+		t.Errorf("should translate default locale, but got %v", result)
+	}
+
+	if result := i18n.T("", "hello", "Jinzhu"); result != "Hello Jinzhu" {
+		t.Errorf("blank locale should be translated as default locale, but got %v", result)
+	}
+
+	i18n.FallbackLocales = map[string][]string{Default: {"en-GB"}}
+	i18n.AddTranslation(&Translation{Key: "colour", Locale: "en-GB", Value: "Colour"})
+	if result := i18n.T(Default, "colour"); result != "Colour" {
+		t.Errorf("fallbacks of default locale should still be used, but got %v", result)
+	}
+}
+
+func BenchmarkTDefaultLocale(b *testing.B) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: Default, Value: "Hello"})
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		i18n.T(Default, "hello")
+	}
+}
+
+func TestSetMissingBehavior(t *testing.T) {
+	i18n := New(&backend{})
+	if result := i18n.T("en-US", "missing.key"); result != "missing.key" {
+		t.Errorf("should return key by default, but got %v", result)
+	}
+
+	if result := i18n.Default("Default Value").T("en-US", "missing.default"); result != "Default Value" {
+		t.Errorf("should return default value, but got %v", result)
+	}
+
+	i18n.SetMissingBehavior(ReturnEmpty)
+	if result := i18n.T("en-US", "missing.empty"); result != "" {
+		t.Errorf("should return blank string with ReturnEmpty, but got %v", result)
+	}
+
+	if result := i18n.Default("Default Value").T("en-US", "missing.empty_default"); result != "" {
+		t.Errorf("should ignore default value with ReturnEmpty, but got %v", result)
+	}
+
+	i18n.SetMissingBehavior(ReturnDefault)
+	if result := i18n.T("en-US", "missing.no_default"); result != "" {
+		t.Errorf("should return blank string without default value with ReturnDefault, but got %v", result)
+	}
+
+	if result := i18n.Default("Default Value").T("en-US", "missing.with_default"); result != "Default Value" {
+		t.Errorf("should return default value with ReturnDefault, but got %v", result)
+	}
+
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello"})
+	if result := i18n.T("en-US", "hello"); result != "Hello" {
+		t.Errorf("existing translations shouldn't be affected, but got %v", result)
+	}
+}
+
+func TestTWithFallback(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.FallbackLocales = map[string][]string{"en-GB": {"en-AU"}}
+	i18n.AddTranslation(&Translation{Key: "colour", Locale: "en-AU", Value: "Colour (AU)"})
+	i18n.AddTranslation(&Translation{Key: "colour", Locale: "en-NZ", Value: "Colour (NZ)"})
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello"})
+
+	if result := i18n.TWithFallback("en-GB", []string{"en-NZ"}, "colour"); result != "Colour (NZ)" {
+		t.Errorf("should fallback to given locales, but got %v", result)
+	}
+
+	if result := i18n.TWithFallback("en-GB", []string{"en-NZ"}, "hello"); result != "Hello" {
+		t.Errorf("should fallback to default locale at last, but got %v", result)
+	}
+
+	if result := i18n.T("en-GB", "colour"); result != "Colour (AU)" {
+		t.Errorf("configured fallbacks shouldn't be changed, but got %v", result)
+	}
+}
+
+func TestPlaceholders(t *testing.T) {
+	backend := &mapBackend{translations: map[string]*Translation{
+		"en-US/hello": {Key: "hello", Locale: "en-US", Value: "Hello"},
+	}}
+	i18n := New(backend)
+
+	i18n.T("en-US", "hello")
+	i18n.T("zh-CN", "missing")
+
+	placeholders := i18n.Placeholders()
+	if len(placeholders) != 1 || placeholders[0].Key != "missing" || placeholders[0].Locale != "zh-CN" || !placeholders[0].Auto {
+		t.Errorf("should flag auto-created translations, but got %v", placeholders)
+	}
+
+	i18n.SaveTranslation(&Translation{Key: "missing", Locale: "zh-CN", Value: "缺失"})
+	if placeholders := i18n.Placeholders(); len(placeholders) != 0 {
+		t.Errorf("translated placeholder shouldn't be flagged, but got %v", placeholders)
+	}
+}
+
+func TestSetAutoCreateBackend(t *testing.T) {
+	fileBackend := &mapBackend{translations: map[string]*Translation{}}
+	draftsBackend := &mapBackend{translations: map[string]*Translation{}}
+	i18n := New(fileBackend, draftsBackend)
+	i18n.SetAutoCreateBackend(draftsBackend)
+
+	i18n.T("en-US", "missing")
+	if len(fileBackend.translations) != 0 || draftsBackend.translations["en-US/missing"] == nil {
+		t.Errorf("auto-created translation should be saved into configured backend")
+	}
+
+	i18n.SaveTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello"})
+	if fileBackend.translations["en-US/hello"] == nil {
+		t.Errorf("other translations should still be saved into first backend")
+	}
+}
+
+func TestSetAutoCreateBackendNormalizeAndCache(t *testing.T) {
+	draftsBackend := &mapBackend{translations: map[string]*Translation{}}
+	i18n := New(&backend{})
+	i18n.NormalizeWhitespace = true
+	i18n.SetAutoCreateBackend(draftsBackend)
+
+	i18n.Default("  Hello   World ").T("en-US", "hello")
+	if translation := draftsBackend.translations["en-US/hello"]; translation == nil || translation.Value != "Hello World" {
+		t.Errorf("auto-created translation should be normalized before saving into configured backend, got %#v", translation)
+	}
+
+	i18n = New(&backend{})
+	i18n.SetAutoCreateBackend(&flakyBackend{failures: 1000})
+
+	i18n.Default("Missing").T("en-US", "missing")
+	if value, ok := i18n.Raw("en-US", "missing"); !ok || value != "Missing" {
+		t.Errorf("missing translation should be cached even if auto-create backend failed to save it, but got %v", value)
+	}
+}
+
+func TestCacheOnlyMode(t *testing.T) {
+	i18n := New()
+
+	if err := i18n.SaveTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello"}); err != nil {
+		t.Errorf("should save translation to cache store without backends, but got %v", err)
+	}
+
+	if result := i18n.T("en-US", "hello"); result != "Hello" {
+		t.Errorf("should return saved translation, but got %v", result)
+	}
+
+	i18n.Default("Missing").T("en-US", "missing")
+	if value, ok := i18n.Raw("en-US", "missing"); !ok || value != "Missing" {
+		t.Errorf("missing translation should be auto created in cache store, but got %v", value)
+	}
+
+	if err := i18n.DeleteTranslation(&Translation{Key: "hello", Locale: "en-US"}); err != nil {
+		t.Errorf("should delete translation from cache store, but got %v", err)
+	}
+
+	if _, ok := i18n.Raw("en-US", "hello"); ok {
+		t.Errorf("translation should be deleted")
+	}
+}
+
+func TestLoadTranslationsSorted(t *testing.T) {
+	i18n := New(&sliceBackend{translations: []*Translation{
+		{Key: "b", Locale: "zh-CN", Value: "乙"},
+		{Key: "a", Locale: "zh-CN", Value: "甲"},
+		{Key: "b", Locale: "en-US", Value: "B"},
+		{Key: "c", Locale: "de-DE", Value: "C"},
+		{Key: "a", Locale: "en-US", Value: "A"},
+	}})
+
+	expected := []string{"de-DE.c", "en-US.a", "en-US.b", "zh-CN.a", "zh-CN.b"}
+	for i := 0; i < 10; i++ {
+		var results []string
+		for _, translation := range i18n.LoadTranslationsSorted() {
+			results = append(results, translation.Locale+"."+translation.Key)
+		}
+
+		if strings.Join(results, ",") != strings.Join(expected, ",") {
+			t.Fatalf("translations should be sorted by locale then key, but got %v", results)
+		}
+	}
+}
+
+func TestMarkUntranslated(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.MarkUntranslated = true
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello"})
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "zh-CN", Value: "你好"})
+	i18n.AddTranslation(&Translation{Key: "bye", Locale: "en-US", Value: "Bye {{$1}}"})
+
+	cases := map[string]template.HTML{
+		"zh-CN.hello": "你好",
+		"en-US.hello": "Hello",
+		"zh-CN.bye":   `<span class="i18n-missing">Bye Jinzhu</span>`,
+		"zh-CN.new":   `<span class="i18n-missing">new</span>`,
+	}
+
+	for name, expected := range cases {
+		parts := strings.SplitN(name, ".", 2)
+		if result := i18n.T(parts[0], parts[1], "Jinzhu"); result != expected {
+			t.Errorf("%v should be %v, but got %v", name, expected, result)
+		}
+	}
+
+	i18n.Default("Created").T("en-US", "created")
+	if result := i18n.T("en-US", "created"); result != `<span class="i18n-missing">Created</span>` {
+		t.Errorf("auto-created translation should be marked, but got %v", result)
+	}
+}
+
+func TestLoadFromBackend(t *testing.T) {
+	database := &sliceBackend{translations: []*Translation{
+		{Key: "hello", Locale: "zh-CN", Value: "你好"},
+		{Key: "hello", Locale: "en-US", Value: "Hello"},
+		{Key: "hello", Locale: "en-US", Value: "Hello again"},
+	}}
+	files := &sliceBackend{translations: []*Translation{
+		{Key: "bye", Locale: "en-US", Value: "Bye"},
+		{Key: "hello", Locale: "en-US", Value: "Hi"},
+	}}
+	i18n := New(database, files)
+
+	var results []string
+	for _, translation := range i18n.LoadFromBackend(database) {
+		results = append(results, translation.Locale+"."+translation.Key+"="+translation.Value)
+	}
+
+	if expected := "en-US.hello=Hello again,zh-CN.hello=你好"; strings.Join(results, ",") != expected {
+		t.Errorf("translations of backend should be %v, but got %v", expected, results)
+	}
+
+	results = nil
+	for _, translation := range i18n.LoadFromBackend(files) {
+		results = append(results, translation.Locale+"."+translation.Key+"="+translation.Value)
+	}
+
+	if expected := "en-US.bye=Bye,en-US.hello=Hi"; strings.Join(results, ",") != expected {
+		t.Errorf("translations of backend should be %v, but got %v", expected, results)
+	}
+}
+
+func TestFallbackLocalesWithoutDuplicates(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.FallbackLocales = map[string][]string{"zh-TW": {"zh-HK", "zh-CN", "zh-HK", "en-US"}}
+
+	cases := []struct {
+		i18n     *I18n
+		locale   string
+		expected []string
+	}{
+		{i18n, "en-US", nil},
+		{i18n, "zh-TW", []string{"zh-HK", "zh-CN", "en-US"}},
+		{i18n.Fallbacks("zh-CN", "zh-TW"), "zh-TW", []string{"zh-CN", "zh-HK", "en-US"}},
+	}
+
+	for _, c := range cases {
+		if result := c.i18n.fallbackLocalesFor(c.locale); strings.Join(result, ",") != strings.Join(c.expected, ",") {
+			t.Errorf("fallback locales of %v should be %v, but got %v", c.locale, c.expected, result)
+		}
+	}
+}
+
+func TestSeedFromKey(t *testing.T) {
+	backend := &sliceBackend{}
+	i18n := New(backend)
+	i18n.SeedFromKey = true
+
+	if value := i18n.T("en-US", "home.welcome_message"); value != "Welcome message" {
+		t.Errorf("missing translation should be seeded with humanized key, got %v", value)
+	}
+
+	if len(backend.translations) != 1 || backend.translations[0].Value != "Welcome message" || !backend.translations[0].Auto {
+		t.Fatalf("seeded translation should be saved, got %v", backend.translations)
+	}
+
+	if value, ok := i18n.Raw("en-US", "home.welcome_message"); !ok || value != "Welcome message" {
+		t.Errorf("seeded translation should be cached, got %v", value)
+	}
+
+	i18n.T("en-US", "home.welcome_message")
+	if len(backend.translations) != 1 {
+		t.Errorf("seeded translation should be found in later lookups, got %v", backend.translations)
+	}
+
+	if value := i18n.Default("Sign in now").T("en-US", "home.sign_in"); value != "Sign in now" || backend.translations[1].Value != "Sign in now" {
+		t.Errorf("default value should be used to seed translation, got %v", value)
+	}
+
+	if placeholders := i18n.Placeholders(); len(placeholders) != 2 {
+		t.Errorf("seeded translations should be placeholders, got %v", placeholders)
+	}
+}
@@ -0,0 +1,221 @@
+package i18n
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ImportStrategy strategy to resolve conflicts with existing translations when importing
+type ImportStrategy int
+
+const (
+	// Skip keep existing translations
+	Skip ImportStrategy = iota
+	// Overwrite replace existing translations
+	Overwrite
+	// OverwriteIfEmpty only replace existing translations with blank value
+	OverwriteIfEmpty
+	// KeepNewer only replace existing translations updated before `ImportOptions.UpdatedAt`
+	KeepNewer
+)
+
+// ImportOptions options for importing translations
+type ImportOptions struct {
+	Strategy ImportStrategy
+	// UpdatedAt the time imported translations were updated, used by `KeepNewer`, default to now
+	UpdatedAt time.Time
+}
+
+// shouldImport check if imported translation should be saved with existing translation of same key
+// Auto created translations are treated as absent, so imports always fill them
+func (i18n *I18n) shouldImport(translation *Translation, options ImportOptions) bool {
+	var existing Translation
+	if err := i18n.cacheStore.Unmarshal(i18n.cacheKeyFor(translation.Locale, translation.Key), &existing); err != nil || existing.Auto {
+		return true
+	}
+
+	switch options.Strategy {
+	case Overwrite:
+		return true
+	case OverwriteIfEmpty:
+		return existing.Value == ""
+	case KeepNewer:
+		updatedAt := options.UpdatedAt
+		if updatedAt.IsZero() {
+			updatedAt = time.Now()
+		}
+		return existing.UpdatedAt.Before(updatedAt)
+	}
+	return false
+}
+
+func importOptionsFrom(opts []ImportOptions) ImportOptions {
+	if len(opts) > 0 {
+		return opts[0]
+	}
+	return ImportOptions{}
+}
+
+// ImportForLocale import translations of one locale from reader and save them, supported formats: json, yaml, csv
+// Translations of other locales won't be touched, it is useful to upload translations from admin
+// Existing translations are kept by default, pass `ImportOptions` to choose another strategy
+func (i18n *I18n) ImportForLocale(locale string, r io.Reader, format string, opts ...ImportOptions) error {
+	if locale == "" {
+		return errors.New("locale is required to import translations")
+	}
+
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	var values map[string]string
+	switch strings.ToLower(format) {
+	case "json":
+		values, err = parseJSONTranslations(locale, content)
+	case "yaml", "yml":
+		values, err = parseYAMLTranslations(locale, content)
+	case "csv":
+		values, err = parseCSVTranslations(locale, content)
+	default:
+		return fmt.Errorf("unsupported import format: %v", format)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to parse %v translations, got: %v", format, err)
+	}
+
+	var keys []string
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var translations []*Translation
+	for _, key := range keys {
+		translations = append(translations, &Translation{Key: key, Locale: locale, Value: values[key]})
+	}
+	return i18n.importTranslations(translations, importOptionsFrom(opts))
+}
+
+// importTranslations save translations resolving conflicts with options, translations are saved with `ImportOptions.UpdatedAt` if it is set
+func (i18n *I18n) importTranslations(translations []*Translation, options ImportOptions) error {
+	for _, translation := range translations {
+		if translation.Key == "" || !i18n.shouldImport(translation, options) {
+			continue
+		}
+
+		translation.UpdatedAt = options.UpdatedAt
+		if err := i18n.SaveTranslation(translation); err != nil {
+			return fmt.Errorf("failed to import translation %v, got: %v", translation.Key, err)
+		}
+	}
+	return nil
+}
+
+// parseJSONTranslations parse flat or nested JSON translations, the content could be wrapped with the locale, e.g: `{"en-US": {"hello": "Hello"}}`
+func parseJSONTranslations(locale string, content []byte) (map[string]string, error) {
+	var data map[string]interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+
+	if len(data) == 1 {
+		if localeData, ok := data[locale].(map[string]interface{}); ok {
+			data = localeData
+		}
+	}
+
+	values := map[string]string{}
+	flattenJSONTranslations(values, data, nil)
+	return values, nil
+}
+
+func flattenJSONTranslations(values map[string]string, data map[string]interface{}, scopes []string) {
+	for key, value := range data {
+		keys := append(append([]string{}, scopes...), key)
+		if v, ok := value.(map[string]interface{}); ok {
+			flattenJSONTranslations(values, v, keys)
+		} else if value != nil {
+			values[strings.Join(keys, ".")] = fmt.Sprint(value)
+		}
+	}
+}
+
+// parseYAMLTranslations parse YAML translations, the content could be wrapped with the locale like files used for YAML backend
+func parseYAMLTranslations(locale string, content []byte) (map[string]string, error) {
+	var slice yaml.MapSlice
+	if err := yaml.Unmarshal(content, &slice); err != nil {
+		return nil, err
+	}
+
+	if len(slice) == 1 && fmt.Sprint(slice[0].Key) == locale {
+		if localeSlice, ok := slice[0].Value.(yaml.MapSlice); ok {
+			slice = localeSlice
+		}
+	}
+
+	values := map[string]string{}
+	flattenYAMLTranslations(values, slice, nil)
+	return values, nil
+}
+
+func flattenYAMLTranslations(values map[string]string, slice yaml.MapSlice, scopes []string) {
+	for _, item := range slice {
+		keys := append(append([]string{}, scopes...), fmt.Sprint(item.Key))
+		if v, ok := item.Value.(yaml.MapSlice); ok {
+			flattenYAMLTranslations(values, v, keys)
+		} else if item.Value != nil {
+			values[strings.Join(keys, ".")] = fmt.Sprint(item.Value)
+		}
+	}
+}
+
+// parseCSVTranslations parse CSV translations with `key,value` rows
+// If the first row is a header starts with `key`, the column named by the locale (or `value`) will be used
+func parseCSVTranslations(locale string, content []byte) (map[string]string, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	column := 1
+	if len(records) > 0 && strings.EqualFold(records[0][0], "key") {
+		column = -1
+		for idx, name := range records[0] {
+			if name == locale || (column == -1 && strings.EqualFold(name, "value")) {
+				column = idx
+			}
+		}
+
+		if column <= 0 {
+			return nil, fmt.Errorf("no column for locale %v", locale)
+		}
+		records = records[1:]
+	}
+
+	values := map[string]string{}
+	for idx, record := range records {
+		if len(record) <= column {
+			return nil, fmt.Errorf("line %v has no value", idx+1)
+		}
+		values[record[0]] = record[column]
+	}
+	return values, nil
+}
@@ -0,0 +1,122 @@
+package i18n
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestImportForLocale(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "home.title", Locale: "en-US", Value: "Home"})
+
+	content := `{"zh-CN": {"home": {"title": "首页", "welcome": "欢迎"}, "count": 10}}`
+	if err := i18n.ImportForLocale("zh-CN", strings.NewReader(content), "json"); err != nil {
+		t.Fatalf("failed to import translations, got %v", err)
+	}
+
+	for key, value := range map[string]string{"home.title": "首页", "home.welcome": "欢迎", "count": "10"} {
+		if result := i18n.T("zh-CN", key); string(result) != value {
+			t.Errorf("translation %v should be imported as %v, but got %v", key, value, result)
+		}
+	}
+
+	if result := i18n.T("en-US", "home.title"); result != "Home" {
+		t.Errorf("translations of other locales shouldn't be affected, but got %v", result)
+	}
+
+	if err := i18n.ImportForLocale("zh-CN", strings.NewReader(`{"home": "首页"`), "json"); err == nil {
+		t.Errorf("should return error for invalid JSON")
+	}
+
+	if err := i18n.ImportForLocale("zh-CN", strings.NewReader(content), "xml"); err == nil {
+		t.Errorf("should return error for unsupported format")
+	}
+}
+
+func TestImportForLocaleWithCSV(t *testing.T) {
+	i18n := New(&backend{})
+
+	content := "key,en-US,zh-CN\nhome.title,Home,首页\n"
+	if err := i18n.ImportForLocale("zh-CN", strings.NewReader(content), "csv"); err != nil {
+		t.Fatalf("failed to import translations, got %v", err)
+	}
+
+	if result := i18n.T("zh-CN", "home.title"); result != "首页" {
+		t.Errorf("translation should be imported from locale's column, but got %v", result)
+	}
+
+	if err := i18n.ImportForLocale("de-DE", strings.NewReader(content), "csv"); err == nil {
+		t.Errorf("should return error if no column for locale")
+	}
+}
+
+func TestImportForLocaleWithStrategies(t *testing.T) {
+	content := `{"a": "new a", "b": "new b", "c": "new c"}`
+	now := time.Now()
+
+	cases := []struct {
+		options  []ImportOptions
+		expected map[string]string
+	}{
+		{nil, map[string]string{"a": "old a", "b": "b", "c": "new c"}},
+		{[]ImportOptions{{Strategy: Skip}}, map[string]string{"a": "old a", "b": "b", "c": "new c"}},
+		{[]ImportOptions{{Strategy: Overwrite}}, map[string]string{"a": "new a", "b": "new b", "c": "new c"}},
+		{[]ImportOptions{{Strategy: OverwriteIfEmpty}}, map[string]string{"a": "old a", "b": "new b", "c": "new c"}},
+		{[]ImportOptions{{Strategy: KeepNewer, UpdatedAt: now}}, map[string]string{"a": "new a", "b": "b", "c": "new c"}},
+	}
+
+	for idx, c := range cases {
+		i18n := New(&backend{})
+		i18n.AddTranslation(&Translation{Key: "a", Locale: "zh-CN", Value: "old a", UpdatedAt: now.Add(-time.Hour)})
+		i18n.AddTranslation(&Translation{Key: "b", Locale: "zh-CN", Value: "", UpdatedAt: now.Add(time.Hour)})
+
+		if err := i18n.ImportForLocale("zh-CN", strings.NewReader(content), "json", c.options...); err != nil {
+			t.Fatalf("#%v: failed to import translations, got %v", idx, err)
+		}
+
+		for key, value := range c.expected {
+			if result := i18n.T("zh-CN", key); string(result) != value {
+				t.Errorf("#%v: translation %v should be %v, but got %v", idx, key, value, result)
+			}
+		}
+	}
+}
+
+func TestImportFillsAutoCreatedTranslations(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.T("zh-CN", "hello")
+
+	if err := i18n.ImportForLocale("zh-CN", strings.NewReader(`{"hello": "你好"}`), "json"); err != nil {
+		t.Fatalf("failed to import translations, got %v", err)
+	}
+	if result := i18n.T("zh-CN", "hello"); result != "你好" {
+		t.Errorf("auto created placeholder should be filled by import, but got %v", result)
+	}
+}
+
+func TestImportKeepNewerWithSourceTime(t *testing.T) {
+	i18n := New(&backend{})
+	exported := time.Now().Add(-24 * time.Hour)
+
+	if err := i18n.ImportForLocale("zh-CN", strings.NewReader(`{"hello": "你好"}`), "json", ImportOptions{Strategy: KeepNewer, UpdatedAt: exported}); err != nil {
+		t.Fatal(err)
+	}
+
+	var translation Translation
+	i18n.cacheStore.Unmarshal(i18n.cacheKeyFor("zh-CN", "hello"), &translation)
+	if !translation.UpdatedAt.Equal(exported) {
+		t.Errorf("imported translation should keep UpdatedAt of source, got %v", translation.UpdatedAt)
+	}
+
+	older := exported.Add(-time.Hour)
+	i18n.ImportForLocale("zh-CN", strings.NewReader(`{"hello": "老的"}`), "json", ImportOptions{Strategy: KeepNewer, UpdatedAt: older})
+	if result := i18n.T("zh-CN", "hello"); result != "你好" {
+		t.Errorf("older import shouldn't replace newer translation, but got %v", result)
+	}
+
+	i18n.ImportForLocale("zh-CN", strings.NewReader(`{"hello": "新的"}`), "json", ImportOptions{Strategy: KeepNewer, UpdatedAt: exported.Add(time.Hour)})
+	if result := i18n.T("zh-CN", "hello"); result != "新的" {
+		t.Errorf("newer import should replace older translation, but got %v", result)
+	}
+}
@@ -0,0 +1,27 @@
+package i18n
+
+import (
+	"html/template"
+	"strings"
+)
+
+// DefaultInlineSeparator default separator of key and default value for `TInline`
+const DefaultInlineSeparator = "|"
+
+// SetInlineSeparator set separator of key and default value for `TInline`
+func (i18n *I18n) SetInlineSeparator(separator string) {
+	i18n.inlineSeparator = separator
+}
+
+// TInline translate key with default value embedded after the separator, e.g: `TInline("en-US", "auth.login|Log in")` translates `auth.login` with default value `Log in`
+func (i18n *I18n) TInline(locale, key string, args ...interface{}) template.HTML {
+	separator := i18n.inlineSeparator
+	if separator == "" {
+		separator = DefaultInlineSeparator
+	}
+
+	if parts := strings.SplitN(key, separator, 2); len(parts) == 2 {
+		return i18n.Default(parts[1]).T(locale, parts[0], args...)
+	}
+	return i18n.T(locale, key, args...)
+}
@@ -0,0 +1,150 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/qor/qor/utils"
+)
+
+// DefaultJQueryVersion default version of jQuery loaded by inline edit assets
+var DefaultJQueryVersion = "2.0.3"
+
+// inline edit asset files, relative to the theme directory `views/themes/i18n`
+const (
+	inlineEditLibsFile = "inline-edit-libs.tmpl"
+	inlineEditCSSFile  = "assets/stylesheets/i18n-inline.css"
+	inlineEditJSFile   = "assets/javascripts/i18n-inline.js"
+)
+
+// InlineEditAssetOptions options to render inline edit assets
+type InlineEditAssetOptions struct {
+	IncludeJQuery         bool
+	IncludeExtendAssetLib bool
+
+	// Dir local theme directory contains inline edit assets, it is `src/github.com/qor/i18n/views/themes/i18n` in GOPATH by default
+	Dir string
+	// FileSystem read inline edit assets from a file system like an embedded one, it has higher priority than Dir
+	FileSystem http.FileSystem
+	// AssetURL link stylesheet and javascript from the URL of theme directory like a CDN instead of inlining them, e.g: `https://cdn.example.com/i18n`
+	// Extend asset libs is a html snippet, it is still read from FileSystem, Dir or GOPATH
+	AssetURL string
+
+	// JQueryVersion version of jQuery loaded from code.jquery.com, `DefaultJQueryVersion` is used if it is blank
+	JQueryVersion string
+	// JQueryURL load jQuery from the URL, it has higher priority than JQueryVersion
+	JQueryURL string
+}
+
+// SetInlineEditAssetOptions set options used by `RenderInlineEditAssets` of the instance
+func (i18n *I18n) SetInlineEditAssetOptions(options InlineEditAssetOptions) {
+	i18n.inlineEditAssets = options
+}
+
+// RenderInlineEditAssets render inline edit html with options set by `SetInlineEditAssetOptions`
+func (i18n *I18n) RenderInlineEditAssets(isIncludeJQuery bool, isIncludeExtendAssetLib bool) (template.HTML, error) {
+	options := i18n.inlineEditAssets
+	options.IncludeJQuery = isIncludeJQuery
+	options.IncludeExtendAssetLib = isIncludeExtendAssetLib
+	return RenderInlineEditAssetsWithOptions(options)
+}
+
+// RenderInlineEditAssetsWithOptions render inline edit html with options, assets are read from FileSystem, Dir or GOPATH
+func RenderInlineEditAssetsWithOptions(options InlineEditAssetOptions) (template.HTML, error) {
+	for _, readAsset := range options.assetReaders() {
+		var content string
+		var hasError bool
+
+		if options.IncludeJQuery {
+			content = fmt.Sprintf(`<script src="%v"></script>`, template.HTMLEscapeString(options.jqueryURL()))
+		}
+
+		if options.IncludeExtendAssetLib {
+			if extendLib, err := readAsset(inlineEditLibsFile); err == nil {
+				content += string(extendLib)
+			} else {
+				hasError = true
+			}
+
+			if options.AssetURL != "" {
+				content += fmt.Sprintf(`<link rel="stylesheet" type="text/css" href="%v">`, options.assetURL(inlineEditCSSFile))
+			} else if css, err := readAsset(inlineEditCSSFile); err == nil {
+				content += fmt.Sprintf("<style>%s</style>", string(css))
+			} else {
+				hasError = true
+			}
+		}
+
+		if options.AssetURL != "" {
+			content += fmt.Sprintf(`<script type="text/javascript" src="%v"></script>`, options.assetURL(inlineEditJSFile))
+		} else if js, err := readAsset(inlineEditJSFile); err == nil {
+			content += fmt.Sprintf("<script type=\"text/javascript\">%s</script>", string(js))
+		} else {
+			hasError = true
+		}
+
+		if !hasError {
+			return template.HTML(content), nil
+		}
+	}
+
+	return template.HTML(""), errors.New("templates not found")
+}
+
+// assetReaders return functions to read inline edit asset, one for each candidate source
+func (options InlineEditAssetOptions) assetReaders() []func(name string) ([]byte, error) {
+	if options.FileSystem != nil {
+		return []func(name string) ([]byte, error){func(name string) ([]byte, error) {
+			file, err := options.FileSystem.Open("/" + name)
+			if err != nil {
+				return nil, err
+			}
+			defer file.Close()
+			return ioutil.ReadAll(file)
+		}}
+	}
+
+	var dirs = []string{options.Dir}
+	if options.Dir == "" {
+		dirs = nil
+		for _, gopath := range utils.GOPATH() {
+			dirs = append(dirs, filepath.Join(gopath, "src/github.com/qor/i18n/views/themes/i18n"))
+		}
+	}
+
+	var readers []func(name string) ([]byte, error)
+	for _, dir := range dirs {
+		dir := dir
+		readers = append(readers, func(name string) ([]byte, error) {
+			return ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		})
+	}
+
+	if len(readers) == 0 {
+		readers = append(readers, func(name string) ([]byte, error) { return nil, os.ErrNotExist })
+	}
+	return readers
+}
+
+func (options InlineEditAssetOptions) jqueryURL() string {
+	if options.JQueryURL != "" {
+		return options.JQueryURL
+	}
+
+	version := options.JQueryVersion
+	if version == "" {
+		version = DefaultJQueryVersion
+	}
+	return fmt.Sprintf("http://code.jquery.com/jquery-%v.min.js", version)
+}
+
+func (options InlineEditAssetOptions) assetURL(name string) string {
+	return template.HTMLEscapeString(strings.TrimSuffix(options.AssetURL, "/") + "/" + path.Clean(name))
+}
@@ -0,0 +1,120 @@
+package i18n
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// setupInlineEditAssets create inline edit assets in a temporary GOPATH
+func setupInlineEditAssets(t *testing.T) func() {
+	gopath, err := ioutil.TempDir("", "i18n-gopath")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := filepath.Join(gopath, "src/github.com/qor/i18n/views/themes/i18n")
+	for path, content := range map[string]string{
+		"inline-edit-libs.tmpl":              "<script>/* libs */</script>",
+		"assets/stylesheets/i18n-inline.css": ".qor-i18n-inline {}",
+		"assets/javascripts/i18n-inline.js":  "/* inline edit */",
+	} {
+		os.MkdirAll(filepath.Dir(filepath.Join(dir, path)), os.ModePerm)
+		if err := ioutil.WriteFile(filepath.Join(dir, path), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	oldGOPATH := os.Getenv("GOPATH")
+	os.Setenv("GOPATH", gopath)
+	return func() {
+		os.Setenv("GOPATH", oldGOPATH)
+		os.RemoveAll(gopath)
+	}
+}
+
+func TestRenderInlineEditAssets(t *testing.T) {
+	defer setupInlineEditAssets(t)()
+
+	assets, err := RenderInlineEditAssets(true, true)
+	if err != nil {
+		t.Fatalf("failed to render inline edit assets, got %v", err)
+	}
+
+	for _, str := range []string{"<script>/* libs */</script>", "<style>.qor-i18n-inline {}</style>", `<script type="text/javascript">/* inline edit */</script>`} {
+		if !strings.Contains(string(assets), str) {
+			t.Errorf("assets should contain %v, but got %v", str, assets)
+		}
+	}
+}
+
+func TestRenderInlineEditAssetsWithOptions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "i18n-assets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for path, content := range map[string]string{
+		"inline-edit-libs.tmpl":              "<script>/* local libs */</script>",
+		"assets/stylesheets/i18n-inline.css": ".local {}",
+		"assets/javascripts/i18n-inline.js":  "/* local inline edit */",
+	} {
+		os.MkdirAll(filepath.Dir(filepath.Join(dir, path)), os.ModePerm)
+		if err := ioutil.WriteFile(filepath.Join(dir, path), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assets, err := RenderInlineEditAssetsWithOptions(InlineEditAssetOptions{Dir: dir, IncludeJQuery: true, IncludeExtendAssetLib: true, JQueryVersion: "3.7.1"})
+	if err != nil {
+		t.Fatalf("failed to render inline edit assets from dir, got %v", err)
+	}
+	for _, str := range []string{`src="http://code.jquery.com/jquery-3.7.1.min.js"`, "/* local libs */", "<style>.local {}</style>", "/* local inline edit */"} {
+		if !strings.Contains(string(assets), str) {
+			t.Errorf("assets should contain %v, but got %v", str, assets)
+		}
+	}
+
+	assets, err = RenderInlineEditAssetsWithOptions(InlineEditAssetOptions{FileSystem: http.Dir(dir), IncludeJQuery: true, JQueryURL: "/static/jquery.js"})
+	if err != nil {
+		t.Fatalf("failed to render inline edit assets from file system, got %v", err)
+	}
+	if expected := `<script src="/static/jquery.js"></script><script type="text/javascript">/* local inline edit */</script>`; string(assets) != expected {
+		t.Errorf("expect assets %v, but got %v", expected, assets)
+	}
+
+	assets, err = RenderInlineEditAssetsWithOptions(InlineEditAssetOptions{Dir: dir, AssetURL: "https://cdn.example.com/i18n/", IncludeExtendAssetLib: true})
+	if err != nil {
+		t.Fatalf("failed to render inline edit assets with asset url, got %v", err)
+	}
+	for _, str := range []string{
+		"/* local libs */",
+		`<link rel="stylesheet" type="text/css" href="https://cdn.example.com/i18n/assets/stylesheets/i18n-inline.css">`,
+		`<script type="text/javascript" src="https://cdn.example.com/i18n/assets/javascripts/i18n-inline.js"></script>`,
+	} {
+		if !strings.Contains(string(assets), str) {
+			t.Errorf("assets should contain %v, but got %v", str, assets)
+		}
+	}
+
+	if _, err := RenderInlineEditAssetsWithOptions(InlineEditAssetOptions{Dir: filepath.Join(dir, "missing")}); err == nil {
+		t.Errorf("should return error if assets not found")
+	}
+}
+
+func TestInstanceInlineEditAssetOptions(t *testing.T) {
+	i18n := New()
+	i18n.SetInlineEditAssetOptions(InlineEditAssetOptions{AssetURL: "/assets/i18n"})
+
+	assets, err := i18n.RenderInlineEditAssets(false, false)
+	if err != nil {
+		t.Fatalf("failed to render inline edit assets, got %v", err)
+	}
+	if expected := `<script type="text/javascript" src="/assets/i18n/assets/javascripts/i18n-inline.js"></script>`; string(assets) != expected {
+		t.Errorf("expect assets %v, but got %v", expected, assets)
+	}
+}
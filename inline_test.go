@@ -0,0 +1,29 @@
+package i18n
+
+import "testing"
+
+func TestTInline(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "auth.logout", Locale: "en-US", Value: "Sign out"})
+
+	if result := i18n.TInline("en-US", "auth.login|Log in"); result != "Log in" {
+		t.Errorf("should return embedded default value, but got %v", result)
+	}
+
+	if value, _ := i18n.Raw("en-US", "auth.login"); value != "Log in" {
+		t.Errorf("missing translation should be created with key before separator, but got %v", value)
+	}
+
+	if result := i18n.TInline("en-US", "auth.logout|Log out"); result != "Sign out" {
+		t.Errorf("should return existing translation, but got %v", result)
+	}
+
+	if result := i18n.TInline("en-US", "auth.welcome|Hello {{$1}} | welcome", "Jinzhu"); result != "Hello Jinzhu | welcome" {
+		t.Errorf("only the first separator should be used, but got %v", result)
+	}
+
+	i18n.SetInlineSeparator("::")
+	if result := i18n.TInline("en-US", "auth.signup::Sign up|Register"); result != "Sign up|Register" {
+		t.Errorf("should use configured separator, but got %v", result)
+	}
+}
@@ -0,0 +1,17 @@
+// Package cacheutil helpers shared by cache stores of i18n
+package cacheutil
+
+import "encoding/json"
+
+// Marshal convert value to string saved in cache stores, strings and bytes are saved as they are, other values are encoded as JSON
+func Marshal(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	}
+
+	bytes, err := json.Marshal(value)
+	return string(bytes), err
+}
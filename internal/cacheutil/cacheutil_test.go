@@ -0,0 +1,24 @@
+package cacheutil
+
+import "testing"
+
+func TestMarshal(t *testing.T) {
+	cases := []struct {
+		value    interface{}
+		expected string
+	}{
+		{"hello", "hello"},
+		{[]byte("hello"), "hello"},
+		{map[string]string{"Key": "hello"}, `{"Key":"hello"}`},
+	}
+
+	for _, c := range cases {
+		if result, err := Marshal(c.value); err != nil || result != c.expected {
+			t.Errorf("%v should be marshaled as %v, but got %v, %v", c.value, c.expected, result, err)
+		}
+	}
+
+	if _, err := Marshal(func() {}); err == nil {
+		t.Errorf("should return error for values couldn't be encoded")
+	}
+}
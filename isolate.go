@@ -0,0 +1,36 @@
+package i18n
+
+import "html/template"
+
+// Unicode directional isolates
+const (
+	FirstStrongIsolate    = "\u2068"
+	PopDirectionalIsolate = "\u2069"
+)
+
+// isolateArgs wrap strings and HTML of args and named args with FSI and PDI, other values like numbers and times are kept as they are, so they could still be formatted by the pattern
+func isolateArgs(args []interface{}) []interface{} {
+	isolated := make([]interface{}, len(args))
+	for idx, arg := range args {
+		if named, ok := arg.(map[string]interface{}); ok {
+			values := make(map[string]interface{}, len(named))
+			for name, value := range named {
+				values[name] = isolateArg(value)
+			}
+			isolated[idx] = values
+		} else {
+			isolated[idx] = isolateArg(arg)
+		}
+	}
+	return isolated
+}
+
+func isolateArg(arg interface{}) interface{} {
+	switch v := arg.(type) {
+	case string:
+		return FirstStrongIsolate + v + PopDirectionalIsolate
+	case template.HTML:
+		return template.HTML(FirstStrongIsolate) + v + template.HTML(PopDirectionalIsolate)
+	}
+	return arg
+}
@@ -0,0 +1,30 @@
+package i18n
+
+import (
+	"html/template"
+	"testing"
+	"time"
+)
+
+func TestIsolateArgs(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Locale: "ar", Key: "greeting", Value: "مرحبا {{$1}}، لديك {{$2}} رسائل"})
+	i18n.AddTranslation(&Translation{Locale: "en-US", Key: "welcome", Value: "Welcome {{.Name}} to {{.City}}"})
+
+	if value := i18n.T("ar", "greeting", "John", 3); value != "مرحبا John، لديك 3 رسائل" {
+		t.Errorf("args shouldn't be isolated by default, got %q", value)
+	}
+
+	i18n.IsolateArgs = true
+	if value := i18n.T("ar", "greeting", "John", 3); value != "مرحبا \u2068John\u2069، لديك 3 رسائل" {
+		t.Errorf("string args should be isolated while numbers are kept, got %q", value)
+	}
+
+	if value := i18n.T("en-US", "welcome", "", map[string]interface{}{"Name": "محمد", "City": "Dubai"}); value != "Welcome \u2068محمد\u2069 to \u2068Dubai\u2069" {
+		t.Errorf("named args should be isolated, got %q", value)
+	}
+
+	if args := isolateArgs([]interface{}{template.HTML("<b>John</b>"), time.Second}); args[0] != template.HTML("\u2068<b>John</b>\u2069") || args[1] != time.Second {
+		t.Errorf("HTML args should be isolated while stringers are kept, got %q", args)
+	}
+}
@@ -0,0 +1,143 @@
+package i18n
+
+import (
+	"sync"
+
+	"github.com/qor/cache/memory"
+)
+
+// LocaleLoader is an optional interface for backends that could load translations of one locale, it is used to load locales in LazyPerLocale mode and by `ClearLocaleCache`
+type LocaleLoader interface {
+	LoadTranslationsOf(locale string) ([]*Translation, error)
+}
+
+type lazyLocales struct {
+	mutex sync.Mutex
+	onces map[string]*sync.Once
+	// pending translations by locale of backends that don't implement LocaleLoader, keyed by index of backend
+	// These backends are loaded once on the first lazy load, translations of locale are removed once it is loaded
+	pending map[int]map[string][]*Translation
+}
+
+// NewLazy initialize I18n with backends in LazyPerLocale mode, only translations of the default locale are loaded at startup
+// Translations of other locales will be loaded when they are looked up the first time, with `LocaleLoader` if backends implement it,
+// other backends are loaded once and translations of locales haven't been looked up are kept until they are looked up or `Reload`
+func NewLazy(backends ...Backend) *I18n {
+	i18n := New()
+	i18n.Backends = backends
+	i18n.LazyPerLocale = true
+	i18n.SetCacheStore(memory.New())
+	return i18n
+}
+
+// loadLocaleLazily load translations of locale into cache store once in LazyPerLocale mode, or after its cache is cleared with `ClearLocaleCache`
+func (i18n *I18n) loadLocaleLazily(locale string) {
+	if i18n.lazyLocales == nil {
+		return
+	}
+
+	i18n.lazyLocales.mutex.Lock()
+	once, ok := i18n.lazyLocales.onces[locale]
+	if !ok {
+		if !i18n.LazyPerLocale {
+			i18n.lazyLocales.mutex.Unlock()
+			return
+		}
+		once = &sync.Once{}
+		i18n.lazyLocales.onces[locale] = once
+	}
+	i18n.lazyLocales.mutex.Unlock()
+
+	once.Do(func() {
+		for i := len(i18n.Backends) - 1; i >= 0; i-- {
+			for _, translation := range i18n.loadPendingLocale(i, locale) {
+				if i18n.isCachedLocale(locale) {
+					i18n.AddTranslation(translation)
+				}
+			}
+		}
+	})
+}
+
+// loadPendingLocale load translations of locale from backend at idx, backends don't implement LocaleLoader are loaded once and split by locale
+func (i18n *I18n) loadPendingLocale(idx int, locale string) []*Translation {
+	backend := i18n.Backends[idx]
+	if _, ok := backend.(LocaleLoader); ok {
+		return i18n.loadBackendLocale(backend, locale)
+	}
+
+	lazy := i18n.lazyLocales
+	lazy.mutex.Lock()
+	defer lazy.mutex.Unlock()
+
+	byLocale, ok := lazy.pending[idx]
+	if !ok {
+		byLocale = map[string][]*Translation{}
+		for _, translation := range i18n.loadBackendTranslations(backend) {
+			byLocale[translation.Locale] = append(byLocale[translation.Locale], translation)
+		}
+		lazy.pending[idx] = byLocale
+	}
+
+	translations := byLocale[locale]
+	delete(byLocale, locale)
+	return translations
+}
+
+// loadBackendLocale load translations of locale from backend, with `LocaleLoader` if backend implements it
+func (i18n *I18n) loadBackendLocale(backend Backend, locale string) []*Translation {
+	if loader, ok := backend.(LocaleLoader); ok {
+		translations, err := loader.LoadTranslationsOf(locale)
+		if err != nil {
+			i18n.logf("Failed to load translations of %v from backend %T, got: %v", locale, backend, err)
+		}
+		return translations
+	}
+
+	var translations []*Translation
+	for _, translation := range i18n.loadBackendTranslations(backend) {
+		if translation.Locale == locale {
+			translations = append(translations, translation)
+		}
+	}
+	return translations
+}
+
+// ClearLocaleCache reload translations of locale from backends into cache store, cached translations of locale that aren't in backends anymore are evicted
+// Cache of other locales won't be affected, it is useful after importing translations of one locale
+// Fresh translations are loaded and swapped in before stale ones are evicted, so lookups during the reload never miss and auto-create them
+func (i18n *I18n) ClearLocaleCache(locale string) {
+	var (
+		translations []*Translation
+		fresh        = map[string]bool{}
+	)
+	for i := len(i18n.Backends) - 1; i >= 0; i-- {
+		translations = append(translations, i18n.loadBackendLocale(i18n.Backends[i], locale)...)
+	}
+
+	if i18n.isCachedLocale(locale) {
+		for _, translation := range translations {
+			i18n.AddTranslation(translation)
+			fresh[translation.Key] = true
+		}
+	}
+
+	for _, key := range i18n.cached.keysOf(locale) {
+		if !fresh[key] {
+			i18n.deleteCached(locale, key)
+		}
+	}
+
+	if i18n.lazyLocales != nil {
+		// the locale has been loaded, won't load it again on next lookup in LazyPerLocale mode
+		loaded := &sync.Once{}
+		loaded.Do(func() {})
+
+		i18n.lazyLocales.mutex.Lock()
+		i18n.lazyLocales.onces[locale] = loaded
+		for _, byLocale := range i18n.lazyLocales.pending {
+			delete(byLocale, locale)
+		}
+		i18n.lazyLocales.mutex.Unlock()
+	}
+}
@@ -0,0 +1,154 @@
+package i18n
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestNewLazy(t *testing.T) {
+	i18n := NewLazy(&sliceBackend{translations: []*Translation{
+		{Key: "hello", Locale: "en-US", Value: "Hello"},
+		{Key: "hello", Locale: "zh-CN", Value: "你好"},
+		{Key: "hello", Locale: "de-DE", Value: "Hallo"},
+	}})
+
+	if _, err := i18n.cacheStore.Get(i18n.cacheKeyFor("en-US", "hello")); err != nil {
+		t.Errorf("default locale should be loaded at startup")
+	}
+
+	if _, err := i18n.cacheStore.Get(i18n.cacheKeyFor("zh-CN", "hello")); err == nil {
+		t.Errorf("other locales shouldn't be loaded at startup")
+	}
+
+	if result := i18n.T("zh-CN", "hello"); result != "你好" {
+		t.Errorf("locale should be loaded on first lookup, but got %v", result)
+	}
+
+	if _, err := i18n.cacheStore.Get(i18n.cacheKeyFor("de-DE", "hello")); err == nil {
+		t.Errorf("locales haven't been looked up shouldn't be loaded")
+	}
+
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "zh-CN", Value: "您好"})
+	if result := i18n.T("zh-CN", "hello"); result != "您好" {
+		t.Errorf("locale should only be loaded once, but got %v", result)
+	}
+}
+
+type localeLoaderBackend struct {
+	countingBackend
+	localeLoads []string
+}
+
+func (b *localeLoaderBackend) LoadTranslationsOf(locale string) (translations []*Translation, err error) {
+	b.localeLoads = append(b.localeLoads, locale)
+	for _, translation := range b.translations {
+		if translation.Locale == locale {
+			translations = append(translations, translation)
+		}
+	}
+	return translations, nil
+}
+
+func TestNewLazyLoadsBackendsOnce(t *testing.T) {
+	backend := &countingBackend{sliceBackend: sliceBackend{translations: []*Translation{
+		{Key: "hello", Locale: "en-US", Value: "Hello"},
+		{Key: "hello", Locale: "zh-CN", Value: "你好"},
+		{Key: "hello", Locale: "de-DE", Value: "Hallo"},
+	}}}
+	loader := &localeLoaderBackend{countingBackend: countingBackend{sliceBackend: sliceBackend{translations: []*Translation{
+		{Key: "bye", Locale: "zh-CN", Value: "再见"},
+		{Key: "bye", Locale: "de-DE", Value: "Tschüss"},
+	}}}}
+	i18n := NewLazy(loader, backend)
+
+	for _, locale := range []string{"zh-CN", "de-DE"} {
+		i18n.T(locale, "hello")
+	}
+
+	if result := i18n.T("de-DE", "hello"); result != "Hallo" {
+		t.Errorf("locale should be loaded from pending translations, but got %v", result)
+	}
+	if result := i18n.T("de-DE", "bye"); result != "Tschüss" {
+		t.Errorf("locale should be loaded with locale loader, but got %v", result)
+	}
+
+	if backend.loads != 1 {
+		t.Errorf("backend should be loaded once for all locales, but loaded %v times", backend.loads)
+	}
+	if loader.loads != 0 || len(loader.localeLoads) != 3 {
+		t.Errorf("locale loader should load each locale, but got %v full loads, %v", loader.loads, loader.localeLoads)
+	}
+}
+
+func TestClearLocaleCache(t *testing.T) {
+	translations := &sliceBackend{translations: []*Translation{
+		{Key: "hello", Locale: "en-US", Value: "Hello"},
+		{Key: "hello", Locale: "zh-CN", Value: "你好"},
+		{Key: "bye", Locale: "zh-CN", Value: "再见"},
+	}}
+	i18n := New(translations)
+	// bye is deleted from backend
+	translations.translations = translations.translations[:2]
+
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello (cached)"})
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "zh-CN", Value: "你好 (cached)"})
+	i18n.AddTranslation(&Translation{Key: "cached", Locale: "zh-CN", Value: "缓存"})
+
+	i18n.ClearLocaleCache("zh-CN")
+	for _, key := range []string{"bye", "cached"} {
+		if _, err := i18n.cacheStore.Get(i18n.cacheKeyFor("zh-CN", key)); err == nil {
+			t.Errorf("cached translation %v not in backends should be evicted", key)
+		}
+	}
+
+	if result := i18n.T("zh-CN", "hello"); result != "你好" {
+		t.Errorf("translations should be reloaded from backends, but got %v", result)
+	}
+
+	if result := i18n.T("en-US", "hello"); result != "Hello (cached)" {
+		t.Errorf("cache of other locales shouldn't be affected, but got %v", result)
+	}
+}
+
+type autoCreateCountingBackend struct {
+	sliceBackend
+	mutex       sync.Mutex
+	autoCreated int
+}
+
+func (b *autoCreateCountingBackend) SaveTranslation(t *Translation) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if t.Auto {
+		b.autoCreated++
+	}
+	return nil
+}
+
+func TestClearLocaleCacheWhileTranslating(t *testing.T) {
+	backend := &autoCreateCountingBackend{sliceBackend: sliceBackend{translations: []*Translation{{Key: "hello", Locale: "zh-CN", Value: "你好"}}}}
+	i18n := New(backend)
+
+	done := make(chan bool)
+	go func() {
+		for i := 0; i < 100; i++ {
+			i18n.ClearLocaleCache("zh-CN")
+		}
+		close(done)
+	}()
+
+	for translating := true; translating; {
+		select {
+		case <-done:
+			translating = false
+		default:
+			if result := i18n.T("zh-CN", "hello"); result != "你好" {
+				t.Fatalf("translation shouldn't be missing while reloading, but got %v", result)
+			}
+		}
+	}
+
+	if backend.autoCreated != 0 {
+		t.Errorf("translations shouldn't be auto created while reloading, got %v", backend.autoCreated)
+	}
+}
@@ -0,0 +1,90 @@
+package i18n
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LintSeverity severity of lint issues
+type LintSeverity string
+
+// Lint severities
+const (
+	LintError   LintSeverity = "error"
+	LintWarning LintSeverity = "warning"
+	LintInfo    LintSeverity = "info"
+)
+
+// LintIssue an issue found by Lint
+type LintIssue struct {
+	Severity LintSeverity
+	Locale   string
+	Key      string
+	Message  string
+}
+
+// Lint check translations loaded from backends for common mistakes, issues are ordered by locale and key
+func (i18n *I18n) Lint() []LintIssue {
+	var (
+		issues       []LintIssue
+		translations = i18n.LoadTranslations()
+		values       = map[string]map[string]bool{}
+		locales      = map[string]int{}
+	)
+
+	for _, translation := range sortTranslations(translations) {
+		if values[translation.Key] == nil {
+			values[translation.Key] = map[string]bool{}
+		}
+		values[translation.Key][translation.Value] = true
+		locales[translation.Key]++
+
+		if translation.Value == "" {
+			continue
+		}
+
+		if translation.Value == translation.Key {
+			issues = append(issues, LintIssue{Severity: LintWarning, Locale: translation.Locale, Key: translation.Key, Message: "value is same as key, it is probably untranslated"})
+		}
+
+		if msg := checkBrackets(translation.Value); msg != "" {
+			issues = append(issues, LintIssue{Severity: LintError, Locale: translation.Locale, Key: translation.Key, Message: msg})
+		}
+
+		if strings.TrimSpace(translation.Value) != translation.Value {
+			issues = append(issues, LintIssue{Severity: LintWarning, Locale: translation.Locale, Key: translation.Key, Message: "value has leading or trailing whitespace"})
+		}
+	}
+
+	for _, translation := range sortTranslations(translations) {
+		if translation.Locale == i18n.getDefaultLocale() && locales[translation.Key] > 1 && len(values[translation.Key]) == 1 && translation.Value != "" {
+			issues = append(issues, LintIssue{Severity: LintInfo, Locale: translation.Locale, Key: translation.Key, Message: fmt.Sprintf("value is same in all %v locales", locales[translation.Key])})
+		}
+	}
+	return issues
+}
+
+// checkBrackets return a message if brackets in value are not matched
+func checkBrackets(value string) string {
+	var (
+		stack []rune
+		pairs = map[rune]rune{')': '(', ']': '[', '}': '{'}
+	)
+
+	for _, r := range value {
+		switch r {
+		case '(', '[', '{':
+			stack = append(stack, r)
+		case ')', ']', '}':
+			if len(stack) == 0 || stack[len(stack)-1] != pairs[r] {
+				return fmt.Sprintf("unmatched bracket %q", r)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if len(stack) > 0 {
+		return fmt.Sprintf("unclosed bracket %q", stack[len(stack)-1])
+	}
+	return ""
+}
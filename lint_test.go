@@ -0,0 +1,45 @@
+package i18n
+
+import "testing"
+
+func TestLint(t *testing.T) {
+	i18n := New(&sliceBackend{translations: []*Translation{
+		{Key: "brand", Locale: "en-US", Value: "QOR"},
+		{Key: "brand", Locale: "zh-CN", Value: "QOR"},
+		{Key: "hello", Locale: "en-US", Value: "Hello {{$1}"},
+		{Key: "hello", Locale: "zh-CN", Value: "hello"},
+		{Key: "title", Locale: "en-US", Value: "Title "},
+		{Key: "title", Locale: "zh-CN", Value: "标题"},
+	}})
+
+	issues := i18n.Lint()
+	expected := []LintIssue{
+		{Severity: LintError, Locale: "en-US", Key: "hello", Message: "unclosed bracket '{'"},
+		{Severity: LintWarning, Locale: "en-US", Key: "title", Message: "value has leading or trailing whitespace"},
+		{Severity: LintWarning, Locale: "zh-CN", Key: "hello", Message: "value is same as key, it is probably untranslated"},
+		{Severity: LintInfo, Locale: "en-US", Key: "brand", Message: "value is same in all 2 locales"},
+	}
+
+	if len(issues) != len(expected) {
+		t.Fatalf("should found %v issues, but got %v", len(expected), issues)
+	}
+
+	for idx, issue := range expected {
+		if issues[idx] != issue {
+			t.Errorf("issue #%v should be %v, but got %v", idx, issue, issues[idx])
+		}
+	}
+}
+
+func TestCheckBrackets(t *testing.T) {
+	for value, unmatched := range map[string]bool{
+		"{{.Name}} (ok) [ok]": false,
+		"(missing":            true,
+		"extra)":              true,
+		"{[}]":                true,
+	} {
+		if msg := checkBrackets(value); (msg != "") != unmatched {
+			t.Errorf("check brackets for %v, should be unmatched: %v, got %v", value, unmatched, msg)
+		}
+	}
+}
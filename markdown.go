@@ -0,0 +1,17 @@
+package i18n
+
+import (
+	"html/template"
+
+	"github.com/microcosm-cc/bluemonday"
+	"gopkg.in/russross/blackfriday.v2"
+)
+
+var markdownPolicy = bluemonday.UGCPolicy()
+
+// TMarkdown translate with locale, key and arguments, then render the result as Markdown
+// Rendered HTML is sanitized, so scripts and unsafe attributes from translations or arguments will be stripped
+func (i18n *I18n) TMarkdown(locale, key string, args ...interface{}) template.HTML {
+	value := i18n.TJSON(locale, key, args...)
+	return template.HTML(markdownPolicy.SanitizeBytes(blackfriday.Run([]byte(value))))
+}
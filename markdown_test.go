@@ -0,0 +1,23 @@
+package i18n
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTMarkdown(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "intro", Locale: "en-US", Value: "Hello **{{$1}}**, read [the docs](http://getqor.com)\n\n- one\n- two"})
+
+	result := string(i18n.TMarkdown("en-US", "intro", "Jinzhu"))
+	for _, html := range []string{"<strong>Jinzhu</strong>", `<a href="http://getqor.com"`, "<li>one</li>", "<li>two</li>"} {
+		if !strings.Contains(result, html) {
+			t.Errorf("rendered markdown should contain %v, but got %v", html, result)
+		}
+	}
+
+	i18n.AddTranslation(&Translation{Key: "unsafe", Locale: "en-US", Value: "Hi<script>alert('xss')</script>"})
+	if result := string(i18n.TMarkdown("en-US", "unsafe")); strings.Contains(result, "<script>") || !strings.Contains(result, "Hi") {
+		t.Errorf("scripts should be stripped, but got %v", result)
+	}
+}
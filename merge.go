@@ -0,0 +1,44 @@
+package i18n
+
+// MergeOptions options to merge translations from another I18n
+type MergeOptions struct {
+	// Overwrite use other's value if a translation exists in both instances, by default the current instance's value is kept
+	Overwrite bool
+	// AddBackends append other's backends to current instance's backends, they will have lower priority
+	AddBackends bool
+}
+
+// Merge copy translations loaded from other's backends and translations in other's cache store into the cache of current instance
+// Cached translations like ones added with `AddTranslation` take precedence over translations of other's backends
+func (i18n *I18n) Merge(other *I18n, options ...MergeOptions) error {
+	var option MergeOptions
+	if len(options) > 0 {
+		option = options[0]
+	}
+
+	translations := other.LoadTranslations()
+	for _, translation := range other.cachedTranslations() {
+		if translations[translation.Locale] == nil {
+			translations[translation.Locale] = map[string]*Translation{}
+		}
+		translations[translation.Locale][translation.Key] = translation
+	}
+
+	for _, translation := range sortTranslations(translations) {
+		if !option.Overwrite {
+			var existing Translation
+			if err := i18n.cacheStore.Unmarshal(i18n.cacheKeyFor(translation.Locale, translation.Key), &existing); err == nil && existing.Value != "" {
+				continue
+			}
+		}
+
+		if err := i18n.AddTranslation(&Translation{Key: translation.Key, Locale: translation.Locale, Value: translation.Value, Backend: translation.Backend}); err != nil {
+			return err
+		}
+	}
+
+	if option.AddBackends {
+		i18n.Backends = append(i18n.Backends, other.Backends...)
+	}
+	return nil
+}
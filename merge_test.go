@@ -0,0 +1,55 @@
+package i18n
+
+import "testing"
+
+func TestMerge(t *testing.T) {
+	i18n := New(&sliceBackend{translations: []*Translation{
+		{Key: "hello", Locale: "en-US", Value: "Hello"},
+	}})
+	other := New(&sliceBackend{translations: []*Translation{
+		{Key: "hello", Locale: "en-US", Value: "Hi"},
+		{Key: "bye", Locale: "en-US", Value: "Bye"},
+	}})
+	other.AddTranslation(&Translation{Key: "welcome", Locale: "en-US", Value: "Welcome"})
+
+	if err := i18n.Merge(other); err != nil {
+		t.Fatalf("failed to merge, got %v", err)
+	}
+
+	if result := i18n.T("en-US", "hello"); result != "Hello" {
+		t.Errorf("current instance's value should take precedence, but got %v", result)
+	}
+
+	if result := i18n.T("en-US", "bye"); result != "Bye" {
+		t.Errorf("other's translation should be merged, but got %v", result)
+	}
+
+	if result := i18n.T("en-US", "welcome"); result != "Welcome" {
+		t.Errorf("translation only in other's cache should be merged, but got %v", result)
+	}
+
+	if len(i18n.Backends) != 1 {
+		t.Errorf("backends shouldn't be merged by default")
+	}
+}
+
+func TestMergeWithOptions(t *testing.T) {
+	i18n := New(&sliceBackend{translations: []*Translation{
+		{Key: "hello", Locale: "en-US", Value: "Hello"},
+	}})
+	other := New(&sliceBackend{translations: []*Translation{
+		{Key: "hello", Locale: "en-US", Value: "Hi"},
+	}})
+
+	if err := i18n.Merge(other, MergeOptions{Overwrite: true, AddBackends: true}); err != nil {
+		t.Fatalf("failed to merge, got %v", err)
+	}
+
+	if result := i18n.T("en-US", "hello"); result != "Hi" {
+		t.Errorf("other's value should overwrite current one, but got %v", result)
+	}
+
+	if len(i18n.Backends) != 2 || i18n.Backends[1] != other.Backends[0] {
+		t.Errorf("other's backends should be appended")
+	}
+}
@@ -0,0 +1,24 @@
+package i18n
+
+// SetMissingHandler set handler to produce value for missing translations when all lookups failed, e.g: fetch it from a remote service
+// The value returned from handler is used instead of the default value and it won't be auto created, blank value is treated as missing
+func (i18n *I18n) SetMissingHandler(handler func(locale, key string) string) {
+	i18n.missingHandler = handler
+}
+
+// handleMissing return value produced by missing handler, panics of handler are recovered and logged
+func (i18n *I18n) handleMissing(locale, key string) (value string, ok bool) {
+	if i18n.missingHandler == nil {
+		return "", false
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			i18n.logf("Missing handler panicked for %v of %v: %v", key, locale, r)
+			value, ok = "", false
+		}
+	}()
+
+	value = i18n.missingHandler(locale, key)
+	return value, value != ""
+}
@@ -0,0 +1,50 @@
+package i18n
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSetMissingHandler(t *testing.T) {
+	backend := &sliceBackend{}
+	i18n := New(backend)
+	i18n.AddTranslation(&Translation{Locale: "en-US", Key: "hello", Value: "Hello"})
+	i18n.SetMissingHandler(func(locale, key string) string {
+		if strings.HasPrefix(key, "computed.") {
+			return strings.ToUpper(strings.TrimPrefix(key, "computed.")) + " ({{$1}}) in " + locale
+		}
+		return ""
+	})
+
+	if value := i18n.T("en-US", "hello"); value != "Hello" {
+		t.Errorf("handler shouldn't be used for existing translations, got %v", value)
+	}
+
+	if value := i18n.T("zh-CN", "computed.title", "qor"); value != "TITLE (qor) in zh-CN" {
+		t.Errorf("handler should supply value for missing translation, got %v", value)
+	}
+	if len(backend.translations) != 0 {
+		t.Errorf("value supplied by handler shouldn't be auto created, got %v", backend.translations)
+	}
+
+	if value := i18n.T("en-US", "unknown"); value != "unknown" {
+		t.Errorf("blank value from handler should be treated as missing, got %v", value)
+	}
+	if len(backend.translations) != 1 {
+		t.Errorf("missing translation should be auto created if handler returns blank, got %v", backend.translations)
+	}
+}
+
+func TestMissingHandlerPanic(t *testing.T) {
+	i18n := New(&backend{})
+	logger := &testLogger{}
+	i18n.SetLogger(logger)
+	i18n.SetMissingHandler(func(locale, key string) string { panic("service unavailable") })
+
+	if value := i18n.T("en-US", "hello"); value != "hello" {
+		t.Errorf("should fall back to key if handler panics, got %v", value)
+	}
+	if len(logger.messages) != 1 || !strings.Contains(logger.messages[0], "service unavailable") {
+		t.Errorf("panic of handler should be logged, got %v", logger.messages)
+	}
+}
@@ -0,0 +1,41 @@
+package i18n
+
+import "time"
+
+// ModifiedSinceBackend is an optional interface for backends that could query translations changed after a time efficiently
+// For other backends, translations will be filtered with `UpdatedAt`
+type ModifiedSinceBackend interface {
+	ModifiedSince(t time.Time) []*Translation
+}
+
+// ModifiedSince return translations changed after t ordered by locale and key, it could be used to sync changes incrementally
+// Translations without `UpdatedAt` are ignored
+func (i18n *I18n) ModifiedSince(t time.Time) []*Translation {
+	var translations = map[string]map[string]*Translation{}
+
+	for i := len(i18n.Backends); i > 0; i-- {
+		var (
+			backend = i18n.Backends[i-1]
+			results []*Translation
+		)
+
+		if modifiedSinceBackend, ok := backend.(ModifiedSinceBackend); ok {
+			results = modifiedSinceBackend.ModifiedSince(t)
+		} else {
+			for _, translation := range i18n.loadBackendTranslations(backend) {
+				if translation.UpdatedAt.After(t) {
+					results = append(results, translation)
+				}
+			}
+		}
+
+		for _, translation := range results {
+			if translations[translation.Locale] == nil {
+				translations[translation.Locale] = map[string]*Translation{}
+			}
+			translations[translation.Locale][translation.Key] = translation
+		}
+	}
+
+	return sortTranslations(translations)
+}
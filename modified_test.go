@@ -0,0 +1,53 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+)
+
+type timeTrackingBackend struct {
+	sliceBackend
+	queries int
+}
+
+func (b *timeTrackingBackend) ModifiedSince(t time.Time) (results []*Translation) {
+	b.queries++
+	for _, translation := range b.translations {
+		if translation.UpdatedAt.After(t) {
+			results = append(results, translation)
+		}
+	}
+	return results
+}
+
+func TestModifiedSince(t *testing.T) {
+	var (
+		now      = time.Now()
+		since    = now.Add(-time.Hour)
+		tracking = &timeTrackingBackend{sliceBackend: sliceBackend{translations: []*Translation{
+			{Key: "hello", Locale: "en-US", Value: "Hello", UpdatedAt: now},
+			{Key: "bye", Locale: "en-US", Value: "Bye", UpdatedAt: now.Add(-2 * time.Hour)},
+		}}}
+		other = &sliceBackend{translations: []*Translation{
+			{Key: "title", Locale: "en-US", Value: "Title", UpdatedAt: now},
+			{Key: "hello", Locale: "en-US", Value: "Hi", UpdatedAt: now},
+			{Key: "untracked", Locale: "en-US", Value: "Untracked"},
+		}}
+	)
+
+	i18n := New(tracking, other)
+	translations := i18n.ModifiedSince(since)
+
+	if len(translations) != 2 || translations[0].Key != "hello" || translations[0].Value != "Hello" || translations[1].Key != "title" {
+		t.Errorf("should return translations modified after time, but got %v", translations)
+	}
+
+	if tracking.queries != 1 {
+		t.Errorf("should query backend implements ModifiedSinceBackend")
+	}
+
+	i18n.SaveTranslation(&Translation{Key: "bye", Locale: "en-US", Value: "Good bye"})
+	if translations := i18n.ModifiedSince(now); len(translations) != 1 || translations[0].Key != "bye" {
+		t.Errorf("saved translation should be marked as modified, but got %v", translations)
+	}
+}
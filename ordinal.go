@@ -0,0 +1,77 @@
+package i18n
+
+import (
+	"html/template"
+
+	"golang.org/x/text/language"
+)
+
+// ordinalRules CLDR ordinal plural rules by language, languages not listed only have category `other`
+var ordinalRules = map[string]func(n int) string{
+	"en": func(n int) string {
+		switch {
+		case n%10 == 1 && n%100 != 11:
+			return "one"
+		case n%10 == 2 && n%100 != 12:
+			return "two"
+		case n%10 == 3 && n%100 != 13:
+			return "few"
+		}
+		return "other"
+	},
+	"fr": func(n int) string {
+		if n == 1 {
+			return "one"
+		}
+		return "other"
+	},
+	"it": func(n int) string {
+		if n == 11 || n == 8 || n == 80 || n == 800 {
+			return "many"
+		}
+		return "other"
+	},
+	"sv": func(n int) string {
+		if (n%10 == 1 || n%10 == 2) && n%100 != 11 && n%100 != 12 {
+			return "one"
+		}
+		return "other"
+	},
+}
+
+// OrdinalCategory return CLDR ordinal plural category of n for locale, e.g: `one` for 1st, `two` for 22nd, `few` for 3rd and `other` for 11th in English
+func OrdinalCategory(locale string, n int) string {
+	if n < 0 {
+		n = -n
+	}
+
+	base, _ := language.Make(locale).Base()
+	if rule, ok := ordinalRules[base.String()]; ok {
+		return rule(n)
+	}
+	return "other"
+}
+
+// TOrdinal translate sub key of ordinal category of n like `rank.one`, `rank.two`, `rank.few`, `rank.other`, or key itself if none of them exists
+// Locales are tried in fallback order, categories are selected with ordinal rules of the CLDR provider for the locale that has translations
+// n formatted with number format of locale is the first argument, e.g: `rank.few: "{{$1}}rd place"`
+func (i18n *I18n) TOrdinal(locale, key string, n int, args ...interface{}) template.HTML {
+	if locale == "" {
+		locale = i18n.getDefaultLocale()
+	}
+	args = append([]interface{}{i18n.FormatNumber(locale, n)}, args...)
+
+	for _, l := range append([]string{locale}, i18n.fallbackLocalesFor(locale)...) {
+		for _, k := range []string{key + "." + i18n.getCLDRProvider().OrdinalCategory(l, n), key + ".other", key} {
+			translationKey := i18n.resolveKeyAlias(k)
+			if i18n.scope != "" {
+				translationKey = joinKey(i18n.scope, translationKey)
+			}
+
+			if _, ok := i18n.lookupTranslation(l, translationKey); ok {
+				return i18n.T(locale, k, args...)
+			}
+		}
+	}
+	return i18n.T(locale, key, args...)
+}
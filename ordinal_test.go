@@ -0,0 +1,72 @@
+package i18n
+
+import "testing"
+
+func TestOrdinalCategory(t *testing.T) {
+	cases := map[int]string{1: "one", 2: "two", 3: "few", 4: "other", 11: "other", 12: "other", 13: "other", 21: "one", 22: "two", 23: "few", 101: "one", 111: "other", 0: "other"}
+	for n, category := range cases {
+		if result := OrdinalCategory("en-US", n); result != category {
+			t.Errorf("ordinal category of %v should be %v, but got %v", n, category, result)
+		}
+	}
+
+	if result := OrdinalCategory("fr-FR", 1); result != "one" {
+		t.Errorf("ordinal category of 1 in French should be one, but got %v", result)
+	}
+	if result := OrdinalCategory("zh-CN", 1); result != "other" {
+		t.Errorf("Chinese only has other ordinal category, but got %v", result)
+	}
+}
+
+func TestTOrdinal(t *testing.T) {
+	i18n := New(&backend{})
+	for category, value := range map[string]string{"one": "{{$1}}st place", "two": "{{$1}}nd place", "few": "{{$1}}rd place", "other": "{{$1}}th place"} {
+		i18n.AddTranslation(&Translation{Locale: "en-US", Key: "rank." + category, Value: value})
+	}
+	i18n.AddTranslation(&Translation{Locale: "zh-CN", Key: "rank", Value: "第{{$1}}名"})
+
+	for n, value := range map[int]string{1: "1st place", 2: "2nd place", 3: "3rd place", 4: "4th place", 11: "11th place", 12: "12th place", 22: "22nd place", 103: "103rd place", 1001: "1,001st place"} {
+		if result := i18n.TOrdinal("en-US", "rank", n); string(result) != value {
+			t.Errorf("ordinal %v should be %v, but got %v", n, value, result)
+		}
+	}
+
+	if result := i18n.TOrdinal("zh-CN", "rank", 3); result != "第3名" {
+		t.Errorf("key should be translated if there is no sub key of categories, got %v", result)
+	}
+}
+
+func TestTOrdinalFallback(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Locale: "en-US", Key: "rank.few", Value: "{{$1}}rd place"})
+	i18n.AddTranslation(&Translation{Locale: "en-US", Key: "rank.other", Value: "{{$1}}th place"})
+
+	if result := i18n.TOrdinal("en-GB", "rank", 3); result != "3rd place" {
+		t.Errorf("should select category with rules of fallback locale, got %v", result)
+	}
+}
+
+type welshCLDRProvider struct {
+	CLDRProvider
+}
+
+func (provider welshCLDRProvider) OrdinalCategory(locale string, n int) string {
+	if locale == "cy" && (n == 1 || n == 2) {
+		return "two"
+	}
+	return provider.CLDRProvider.OrdinalCategory(locale, n)
+}
+
+func TestTOrdinalWithCLDRProvider(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.SetCLDRProvider(welshCLDRProvider{DefaultCLDRProvider})
+	i18n.AddTranslation(&Translation{Locale: "cy", Key: "rank.two", Value: "{{$1}}ail"})
+	i18n.AddTranslation(&Translation{Locale: "cy", Key: "rank.other", Value: "{{$1}}fed"})
+
+	if result := i18n.TOrdinal("cy", "rank", 2); result != "2ail" {
+		t.Errorf("should select category with rules of CLDR provider, got %v", result)
+	}
+	if result := i18n.TOrdinal("cy", "rank", 5); result != "5fed" {
+		t.Errorf("should fall back to other category, got %v", result)
+	}
+}
@@ -0,0 +1,29 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Pinger is an optional interface for backends that could check if they are reachable
+type Pinger interface {
+	Ping() error
+}
+
+// Ping check all backends implement Pinger are reachable, errors of all backends will be combined together
+func (i18n *I18n) Ping() error {
+	var messages []string
+	for idx, backend := range i18n.Backends {
+		if pinger, ok := backend.(Pinger); ok {
+			if err := pinger.Ping(); err != nil {
+				messages = append(messages, fmt.Sprintf("backend #%v (%T): %v", idx, backend, err))
+			}
+		}
+	}
+
+	if len(messages) > 0 {
+		return errors.New(strings.Join(messages, "; "))
+	}
+	return nil
+}
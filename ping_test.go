@@ -0,0 +1,27 @@
+package i18n
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type pingBackend struct {
+	backend
+	err error
+}
+
+func (b *pingBackend) Ping() error { return b.err }
+
+func TestPing(t *testing.T) {
+	i18n := New(&pingBackend{}, &backend{})
+	if err := i18n.Ping(); err != nil {
+		t.Errorf("healthy backends should pass ping, but got %v", err)
+	}
+
+	i18n = New(&pingBackend{}, &pingBackend{err: errors.New("connection refused")}, &pingBackend{err: errors.New("timeout")})
+	err := i18n.Ping()
+	if err == nil || !strings.Contains(err.Error(), "#1") || !strings.Contains(err.Error(), "connection refused") || !strings.Contains(err.Error(), "timeout") {
+		t.Errorf("errors of unhealthy backends should be combined, but got %v", err)
+	}
+}
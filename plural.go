@@ -0,0 +1,90 @@
+package i18n
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// PluralCategories CLDR plural categories
+var PluralCategories = []string{"zero", "one", "two", "few", "many", "other"}
+
+var pluralCaseRegexp = regexp.MustCompile(`\(\s*(zero|one|two|few|many|other)\s+("(?:[^"\\]|\\.)*"|` + "`[^`]*`" + `)\s*\)`)
+
+// PluralForms return plural forms of key for locale as category => value, e.g: `{"one": "{{.Count}} item", "other": "{{.Count}} items"}`
+// Forms are read from sub keys like `key.one`, `key.other`, or from the CLDR `p` function in key's value, missing categories are omitted
+// All forms come from the first locale in the fallback chain that has any of them, so forms of different languages won't be mixed
+func (i18n *I18n) PluralForms(locale, key string) map[string]string {
+	for _, l := range append([]string{locale}, i18n.fallbackLocalesFor(locale)...) {
+		forms := map[string]string{}
+		for _, category := range PluralCategories {
+			if translation, ok := i18n.lookupTranslation(l, key+"."+category); ok {
+				forms[category] = translation.Value
+			}
+		}
+
+		if len(forms) == 0 {
+			if translation, ok := i18n.lookupTranslation(l, key); ok {
+				for _, matches := range pluralCaseRegexp.FindAllStringSubmatch(translation.Value, -1) {
+					if value, err := strconv.Unquote(matches[2]); err == nil {
+						forms[matches[1]] = value
+					}
+				}
+			}
+		}
+
+		if len(forms) > 0 {
+			return forms
+		}
+	}
+	return map[string]string{}
+}
+
+func isPluralCategory(category string) bool {
+	for _, c := range PluralCategories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+type pluralForm struct {
+	Category string
+	Value    string
+}
+
+type pluralTranslation struct {
+	Key   string
+	Forms []pluralForm
+}
+
+// groupPluralTranslations split translations into singulars and plurals ordered by key, keys end with plural categories like `key.one` are grouped as plural forms of `key`
+func groupPluralTranslations(translations map[string]*Translation) (singulars []*Translation, plurals []*pluralTranslation) {
+	var groups = map[string]*pluralTranslation{}
+
+	for _, key := range sortedTranslationKeys(translations) {
+		translation := translations[key]
+		if idx := lastSeparatorIndex(key); idx > 0 && isPluralCategory(key[idx+1:]) {
+			plural, ok := groups[key[:idx]]
+			if !ok {
+				plural = &pluralTranslation{Key: key[:idx]}
+				groups[key[:idx]] = plural
+				plurals = append(plurals, plural)
+			}
+			plural.Forms = append(plural.Forms, pluralForm{Category: key[idx+1:], Value: translation.Value})
+			continue
+		}
+		singulars = append(singulars, translation)
+	}
+	return singulars, plurals
+}
+
+func sortedTranslationKeys(translations map[string]*Translation) []string {
+	var keys []string
+	for key := range translations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,59 @@
+package i18n
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPluralForms(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "apples.one", Locale: "ru-RU", Value: "{{.Count}} яблоко"})
+	i18n.AddTranslation(&Translation{Key: "apples.few", Locale: "ru-RU", Value: "{{.Count}} яблока"})
+	i18n.AddTranslation(&Translation{Key: "apples.many", Locale: "ru-RU", Value: "{{.Count}} яблок"})
+	i18n.AddTranslation(&Translation{Key: "apples.other", Locale: "ru-RU", Value: "{{.Count}} яблока"})
+
+	expected := map[string]string{"one": "{{.Count}} яблоко", "few": "{{.Count}} яблока", "many": "{{.Count}} яблок", "other": "{{.Count}} яблока"}
+	if forms := i18n.PluralForms("ru-RU", "apples"); !reflect.DeepEqual(forms, expected) {
+		t.Errorf("should return available plural forms, but got %v", forms)
+	}
+
+	i18n.AddTranslation(&Translation{Key: "count", Locale: "en-US", Value: `{{p "Count" (one "{{.Count}} item") (other "{{.Count}} items")}}`})
+	expected = map[string]string{"one": "{{.Count}} item", "other": "{{.Count}} items"}
+	if forms := i18n.PluralForms("en-US", "count"); !reflect.DeepEqual(forms, expected) {
+		t.Errorf("should return plural forms from CLDR value, but got %v", forms)
+	}
+
+	if forms := i18n.PluralForms("en-US", "missing"); len(forms) != 0 {
+		t.Errorf("should return no forms for missing key, but got %v", forms)
+	}
+}
+
+func TestPluralFormsWithFallbacks(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "files.one", Locale: "ru-RU", Value: "{{.Count}} файл"})
+	i18n.AddTranslation(&Translation{Key: "files.one", Locale: "en-US", Value: "{{.Count}} file"})
+	i18n.AddTranslation(&Translation{Key: "files.other", Locale: "en-US", Value: "{{.Count}} files"})
+
+	if forms := i18n.PluralForms("ru-RU", "files"); !reflect.DeepEqual(forms, map[string]string{"one": "{{.Count}} файл"}) {
+		t.Errorf("forms shouldn't be mixed with fallback locale, but got %v", forms)
+	}
+
+	if forms := i18n.PluralForms("de-DE", "files"); !reflect.DeepEqual(forms, map[string]string{"one": "{{.Count}} file", "other": "{{.Count}} files"}) {
+		t.Errorf("forms should be read from fallback locale if locale has none, but got %v", forms)
+	}
+}
+
+func TestGroupPluralTranslationsWithEscapedDots(t *testing.T) {
+	singulars, plurals := groupPluralTranslations(map[string]*Translation{
+		`apps.v1\.one`:     {Key: `apps.v1\.one`, Value: "Version one"},
+		`version\.2.one`:   {Key: `version\.2.one`, Value: "%d item"},
+		`version\.2.other`: {Key: `version\.2.other`, Value: "%d items"},
+	})
+
+	if len(singulars) != 1 || singulars[0].Key != `apps.v1\.one` {
+		t.Errorf("key ends with escaped plural category shouldn't be grouped as plural, but got %v", singulars)
+	}
+	if len(plurals) != 1 || plurals[0].Key != `version\.2` || len(plurals[0].Forms) != 2 {
+		t.Errorf("plural forms should be grouped by key with escaped dots, but got %#v", plurals)
+	}
+}
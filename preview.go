@@ -0,0 +1,27 @@
+package i18n
+
+import "html/template"
+
+// Preview render value with arguments for locale like T, without looking up or saving translations, parse error is returned so translators could fix the pattern before saving
+// References to other keys aren't resolved
+func (i18n *I18n) Preview(locale, value string, args ...interface{}) (template.HTML, error) {
+	if locale == "" {
+		locale = i18n.getDefaultLocale()
+	}
+
+	formatter := i18n.formatter
+	if formatter == nil {
+		formatter = CLDRFormatter
+	}
+	value, args = applyNamedArgs(formatter, value, args)
+
+	if formatter == CLDRFormatter {
+		formatter = FormatterFunc(i18n.getCLDRProvider().Parse)
+	}
+
+	str, err := formatter.Format(locale, value, args...)
+	if err != nil {
+		return template.HTML(""), err
+	}
+	return template.HTML(str), nil
+}
@@ -0,0 +1,24 @@
+package i18n
+
+import "testing"
+
+func TestPreview(t *testing.T) {
+	backend := &sliceBackend{}
+	i18n := New(backend)
+
+	value, err := i18n.Preview("en-US", "Hello {{$1}}, welcome to {{.City}}", "Jinzhu", map[string]interface{}{"City": "Hangzhou"})
+	if err != nil {
+		t.Fatalf("failed to preview valid pattern, got %v", err)
+	}
+	if value != "Hello Jinzhu, welcome to Hangzhou" {
+		t.Errorf("unexpected preview %v", value)
+	}
+
+	if _, err := i18n.Preview("en-US", "Hello {{$1", "Jinzhu"); err == nil {
+		t.Errorf("should return error for invalid pattern")
+	}
+
+	if len(backend.translations) != 0 || len(i18n.LoadTranslations()) != 0 {
+		t.Errorf("preview shouldn't save anything")
+	}
+}
@@ -0,0 +1,12 @@
+package i18n
+
+// Raw return stored value of translation without fallback or parsing, it won't create missing translations
+func (i18n *I18n) Raw(locale, key string) (string, bool) {
+	i18n.loadLocaleLazily(locale)
+
+	var translation Translation
+	if err := i18n.cacheStore.Unmarshal(i18n.cacheKeyFor(locale, key), &translation); err != nil {
+		return "", false
+	}
+	return translation.Value, true
+}
@@ -0,0 +1,24 @@
+package i18n
+
+import "testing"
+
+func TestRaw(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello {{$1}}"})
+
+	if value, ok := i18n.Raw("en-US", "hello"); !ok || value != "Hello {{$1}}" {
+		t.Errorf("should return unparsed value, but got %v", value)
+	}
+
+	if _, ok := i18n.Raw("zh-CN", "hello"); ok {
+		t.Errorf("should not fall back to other locales")
+	}
+
+	if _, ok := i18n.Raw("en-US", "missing"); ok {
+		t.Errorf("should not find missing translation")
+	}
+
+	if _, ok := i18n.Raw("en-US", "missing"); ok {
+		t.Errorf("should not create missing translation")
+	}
+}
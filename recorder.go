@@ -0,0 +1,47 @@
+package i18n
+
+import "sync"
+
+// Lookup a translation lookup recorded in Recorder mode
+type Lookup struct {
+	Locale string
+	Key    string
+	Args   []interface{}
+}
+
+type lookupRecorder struct {
+	mutex   sync.Mutex
+	lookups []Lookup
+}
+
+func (i18n *I18n) recordLookup(locale, key string, args []interface{}) {
+	if !i18n.Recorder || i18n.recorder == nil {
+		return
+	}
+
+	i18n.recorder.mutex.Lock()
+	i18n.recorder.lookups = append(i18n.recorder.lookups, Lookup{Locale: locale, Key: key, Args: args})
+	i18n.recorder.mutex.Unlock()
+}
+
+// RecordedLookups return lookups recorded in Recorder mode in order, keys are recorded with scope
+func (i18n *I18n) RecordedLookups() []Lookup {
+	if i18n.recorder == nil {
+		return nil
+	}
+
+	i18n.recorder.mutex.Lock()
+	defer i18n.recorder.mutex.Unlock()
+	return append([]Lookup{}, i18n.recorder.lookups...)
+}
+
+// ResetRecordedLookups clear recorded lookups
+func (i18n *I18n) ResetRecordedLookups() {
+	if i18n.recorder == nil {
+		return
+	}
+
+	i18n.recorder.mutex.Lock()
+	i18n.recorder.lookups = nil
+	i18n.recorder.mutex.Unlock()
+}
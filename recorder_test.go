@@ -0,0 +1,47 @@
+package i18n
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestRecorder(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.T("en-US", "not_recorded")
+
+	i18n.Recorder = true
+	i18n.T("en-US", "hello", "Jinzhu")
+	i18n.Scope("home").T("zh-CN", "title")
+	i18n.T("", "bye")
+
+	expected := []Lookup{
+		{Locale: "en-US", Key: "hello", Args: []interface{}{"Jinzhu"}},
+		{Locale: "zh-CN", Key: "home.title"},
+		{Locale: Default, Key: "bye"},
+	}
+	if lookups := i18n.RecordedLookups(); !reflect.DeepEqual(lookups, expected) {
+		t.Errorf("recorded lookups should be %v, but got %v", expected, lookups)
+	}
+
+	i18n.ResetRecordedLookups()
+	if lookups := i18n.RecordedLookups(); len(lookups) != 0 {
+		t.Errorf("recorded lookups should be reset, but got %v", lookups)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				i18n.T("en-US", "hello")
+			}
+		}()
+	}
+	wg.Wait()
+
+	if lookups := i18n.RecordedLookups(); len(lookups) != 100 {
+		t.Errorf("concurrent lookups should be recorded, but got %v", len(lookups))
+	}
+}
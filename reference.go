@@ -0,0 +1,30 @@
+package i18n
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// referenceRegexp match `@:key`, segments of key could have `-` and escaped dots or backslashes like `v1\.2`
+var referenceRegexp = regexp.MustCompile(`@:((?:[\w-]|\\[\\.])+(?:\.(?:[\w-]|\\[\\.])+)*)`)
+
+// resolveReferences inline translations referenced with `@:key` in value, e.g: `Welcome to @:app.name`
+// References are looked up in locale and its fallback locales, missing or cyclic references are kept as they are
+func (i18n *I18n) resolveReferences(locale, key, value string, visited map[string]bool) string {
+	return referenceRegexp.ReplaceAllStringFunc(value, func(reference string) string {
+		refKey := reference[2:]
+		if visited[refKey] {
+			i18n.reportParseError(locale, key, value, fmt.Errorf("cyclic reference %v", reference))
+			return reference
+		}
+
+		translation, ok := i18n.findTranslation(locale, refKey)
+		if !ok {
+			return reference
+		}
+
+		visited[refKey] = true
+		defer delete(visited, refKey)
+		return i18n.resolveReferences(locale, refKey, translation.Value, visited)
+	})
+}
@@ -0,0 +1,50 @@
+package i18n
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReferences(t *testing.T) {
+	var errs []string
+	i18n := New(&backend{})
+	i18n.OnParseError = func(locale, key, value string, err error) {
+		errs = append(errs, err.Error())
+	}
+
+	i18n.AddTranslation(&Translation{Key: "app.name", Locale: "en-US", Value: "QOR"})
+	i18n.AddTranslation(&Translation{Key: "app.name", Locale: "zh-CN", Value: "QOR 中文"})
+	i18n.AddTranslation(&Translation{Key: "app.slogan", Locale: "en-US", Value: "@:app.name, the best"})
+	i18n.AddTranslation(&Translation{Key: "welcome", Locale: "en-US", Value: "Welcome to @:app.name, {{$1}}."})
+	i18n.AddTranslation(&Translation{Key: "title", Locale: "en-US", Value: "@:app.slogan!"})
+	i18n.AddTranslation(&Translation{Key: "ping", Locale: "en-US", Value: "ping @:pong"})
+	i18n.AddTranslation(&Translation{Key: "pong", Locale: "en-US", Value: "pong @:ping"})
+	i18n.AddTranslation(&Translation{Key: "email", Locale: "en-US", Value: "Send to a@:missing"})
+	i18n.AddTranslation(&Translation{Key: "sign-up.title", Locale: "en-US", Value: "Sign up"})
+	i18n.AddTranslation(&Translation{Key: `api.v1\.2`, Locale: "en-US", Value: "API v1.2"})
+	i18n.AddTranslation(&Translation{Key: "header", Locale: "en-US", Value: `@:sign-up.title for @:api.v1\.2.`})
+
+	cases := []struct {
+		locale, key, expected string
+	}{
+		{"en-US", "welcome", "Welcome to QOR, Jinzhu."},
+		{"zh-CN", "welcome", "Welcome to QOR 中文, Jinzhu."},
+		{"en-US", "title", "QOR, the best!"},
+		{"en-US", "email", "Send to a@:missing"},
+		{"en-US", "header", "Sign up for API v1.2."},
+	}
+
+	for _, c := range cases {
+		if result := i18n.T(c.locale, c.key, "Jinzhu"); string(result) != c.expected {
+			t.Errorf("%v of %v should be %v, but got %v", c.key, c.locale, c.expected, result)
+		}
+	}
+
+	if result := i18n.T("en-US", "ping"); result != "ping pong @:ping" {
+		t.Errorf("cyclic reference should be kept, but got %v", result)
+	}
+
+	if len(errs) != 1 || !strings.Contains(errs[0], "cyclic reference @:ping") {
+		t.Errorf("cyclic reference should be reported, but got %v", errs)
+	}
+}
@@ -0,0 +1,51 @@
+package i18n
+
+import (
+	"reflect"
+
+	"github.com/qor/qor/utils"
+)
+
+// RegisterStruct register exported fields of struct v as translation keys `scope.FieldName` of the default locale, with humanized field names as values
+// Key of a field could be customized with tag `i18n:"name"`, or skipped with `i18n:"-"`, existing translations won't be changed
+func (i18n *I18n) RegisterStruct(scope string, v interface{}) {
+	typ := reflect.TypeOf(v)
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.Anonymous {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+
+			if fieldType.Kind() == reflect.Struct {
+				i18n.RegisterStruct(scope, reflect.New(fieldType).Interface())
+				continue
+			}
+		}
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := field.Name
+		if tag := field.Tag.Get("i18n"); tag == "-" {
+			continue
+		} else if tag != "" {
+			name = tag
+		}
+
+		key := scope + "." + name
+		if _, ok := i18n.lookupTranslation(i18n.getDefaultLocale(), key); !ok {
+			i18n.autoCreate(&Translation{Key: key, Locale: i18n.getDefaultLocale(), Value: utils.HumanizeString(field.Name), Auto: true})
+		}
+	}
+}
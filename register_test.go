@@ -0,0 +1,45 @@
+package i18n
+
+import "testing"
+
+type registerTimestamps struct {
+	CreatedAt string
+}
+
+type registerUser struct {
+	registerTimestamps
+	FirstName string
+	Email     string `i18n:"email_address"`
+	Password  string `i18n:"-"`
+	role      string
+}
+
+func TestRegisterStruct(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "user.Email", Locale: "en-US", Value: "E-mail"})
+
+	i18n.RegisterStruct("user", &registerUser{})
+
+	for key, value := range map[string]string{
+		"user.FirstName":     "First Name",
+		"user.email_address": "Email",
+		"user.CreatedAt":     "Created At",
+		"user.Email":         "E-mail",
+	} {
+		if result, ok := i18n.Raw("en-US", key); !ok || result != value {
+			t.Errorf("%v should be registered as %v, but got %v", key, value, result)
+		}
+	}
+
+	for _, key := range []string{"user.Password", "user.role"} {
+		if _, ok := i18n.Raw("en-US", key); ok {
+			t.Errorf("%v shouldn't be registered", key)
+		}
+	}
+
+	i18n.AddTranslation(&Translation{Key: "user.FirstName", Locale: "en-US", Value: "Given Name"})
+	i18n.RegisterStruct("user", registerUser{})
+	if result, _ := i18n.Raw("en-US", "user.FirstName"); result != "Given Name" {
+		t.Errorf("existing translations shouldn't be changed, but got %v", result)
+	}
+}
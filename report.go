@@ -0,0 +1,53 @@
+package i18n
+
+import "strings"
+
+// CoverageStat translation coverage of a locale relative to keys of the default locale
+type CoverageStat struct {
+	Translated int
+	Total      int
+	Percentage float64
+}
+
+// Report return translation coverage of all locales that have translations, keys with blank value in the default locale are not counted
+func (i18n *I18n) Report() map[string]CoverageStat {
+	translations := i18n.LoadTranslations()
+
+	var keys []string
+	for key, translation := range translations[i18n.getDefaultLocale()] {
+		if translation.Value != "" {
+			keys = append(keys, key)
+		}
+	}
+
+	report := map[string]CoverageStat{}
+	for locale, values := range translations {
+		stat := CoverageStat{Total: len(keys)}
+		for _, key := range keys {
+			if translation, ok := values[key]; ok && translation.Value != "" {
+				stat.Translated++
+			}
+		}
+
+		if stat.Total > 0 {
+			stat.Percentage = float64(stat.Translated) * 100 / float64(stat.Total)
+		}
+		report[locale] = stat
+	}
+	return report
+}
+
+// IsComplete check every key of the default locale has a non-blank value in locale, whitespace-only values are treated as untranslated
+func (i18n *I18n) IsComplete(locale string) bool {
+	translations := i18n.LoadTranslations()
+	for key, translation := range translations[i18n.getDefaultLocale()] {
+		if strings.TrimSpace(translation.Value) == "" {
+			continue
+		}
+
+		if value, ok := translations[locale][key]; !ok || strings.TrimSpace(value.Value) == "" {
+			return false
+		}
+	}
+	return true
+}
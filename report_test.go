@@ -0,0 +1,51 @@
+package i18n
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReport(t *testing.T) {
+	i18n := New(&sliceBackend{translations: []*Translation{
+		{Key: "a", Locale: "en-US", Value: "A"},
+		{Key: "b", Locale: "en-US", Value: "B"},
+		{Key: "c", Locale: "en-US", Value: "C"},
+		{Key: "d", Locale: "en-US", Value: "D"},
+		{Key: "blank", Locale: "en-US", Value: ""},
+		{Key: "a", Locale: "zh-CN", Value: "甲"},
+		{Key: "b", Locale: "zh-CN", Value: ""},
+		{Key: "extra", Locale: "zh-CN", Value: "额外"},
+		{Key: "a", Locale: "de-DE", Value: "A"},
+		{Key: "b", Locale: "de-DE", Value: "B"},
+		{Key: "c", Locale: "de-DE", Value: "C"},
+	}})
+
+	expected := map[string]CoverageStat{
+		"en-US": {Translated: 4, Total: 4, Percentage: 100},
+		"zh-CN": {Translated: 1, Total: 4, Percentage: 25},
+		"de-DE": {Translated: 3, Total: 4, Percentage: 75},
+	}
+
+	if report := i18n.Report(); !reflect.DeepEqual(report, expected) {
+		t.Errorf("report should be %v, but got %v", expected, report)
+	}
+}
+
+func TestIsComplete(t *testing.T) {
+	i18n := New(&sliceBackend{translations: []*Translation{
+		{Key: "a", Locale: "en-US", Value: "A"},
+		{Key: "b", Locale: "en-US", Value: "B"},
+		{Key: "blank", Locale: "en-US", Value: " "},
+		{Key: "a", Locale: "zh-CN", Value: "甲"},
+		{Key: "b", Locale: "zh-CN", Value: "乙"},
+		{Key: "a", Locale: "de-DE", Value: "A"},
+		{Key: "b", Locale: "de-DE", Value: "  "},
+		{Key: "a", Locale: "ja-JP", Value: "ア"},
+	}})
+
+	for locale, complete := range map[string]bool{"en-US": true, "zh-CN": true, "de-DE": false, "ja-JP": false, "fr-FR": false} {
+		if result := i18n.IsComplete(locale); result != complete {
+			t.Errorf("%v should be complete: %v, but got %v", locale, complete, result)
+		}
+	}
+}
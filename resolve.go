@@ -0,0 +1,132 @@
+package i18n
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// LocaleSource source to read locale from requests
+type LocaleSource int
+
+const (
+	// FromQuery read locale from query param
+	FromQuery LocaleSource = iota
+	// FromCookie read locale from cookie
+	FromCookie
+	// FromHeader read locale from header, values like `Accept-Language` are parsed with quality weights
+	FromHeader
+)
+
+// ResolveOptions options for `ResolveLocale`
+type ResolveOptions struct {
+	// Order sources are checked in order, default to query param, cookie, then header
+	Order []LocaleSource
+	// QueryParam name of query param, default to `locale`
+	QueryParam string
+	// CookieName name of cookie, default to `locale`
+	CookieName string
+	// Header name of header, default to `Accept-Language`
+	Header string
+	// Supported only return locales in the list if not blank, locales from header match supported locales with same language, e.g: `zh` matches `zh-CN`
+	Supported []string
+	// Default locale returned if no source has a supported locale, default to the default locale of I18n
+	Default string
+}
+
+// ResolveLocale resolve locale of request from sources in order of options, it lets users override the language of their browsers with query param or cookie
+func (i18n *I18n) ResolveLocale(req *http.Request, opts ResolveOptions) string {
+	order := opts.Order
+	if len(order) == 0 {
+		order = []LocaleSource{FromQuery, FromCookie, FromHeader}
+	}
+
+	for _, source := range order {
+		var candidates []string
+		switch source {
+		case FromQuery:
+			candidates = []string{req.URL.Query().Get(stringOr(opts.QueryParam, "locale"))}
+		case FromCookie:
+			if cookie, err := req.Cookie(stringOr(opts.CookieName, "locale")); err == nil {
+				candidates = []string{cookie.Value}
+			}
+		case FromHeader:
+			candidates = parseAcceptLanguage(req.Header.Get(stringOr(opts.Header, "Accept-Language")))
+		}
+
+		for _, candidate := range candidates {
+			if locale := matchLocale(candidate, opts.Supported, source == FromHeader); locale != "" {
+				return locale
+			}
+		}
+	}
+
+	if opts.Default != "" {
+		return opts.Default
+	}
+	return i18n.getDefaultLocale()
+}
+
+func stringOr(str, defaultValue string) string {
+	if str != "" {
+		return str
+	}
+	return defaultValue
+}
+
+// parseAcceptLanguage parse `Accept-Language` header, languages are ordered by quality weights, e.g: `zh-CN,en;q=0.8` => `zh-CN`, `en`
+func parseAcceptLanguage(header string) []string {
+	type language struct {
+		tag     string
+		quality float64
+	}
+
+	var languages []language
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		lang := language{tag: strings.TrimSpace(fields[0]), quality: 1}
+		for _, field := range fields[1:] {
+			if field = strings.TrimSpace(field); strings.HasPrefix(field, "q=") {
+				if quality, err := strconv.ParseFloat(field[2:], 64); err == nil {
+					lang.quality = quality
+				}
+			}
+		}
+
+		if lang.tag != "" && lang.tag != "*" && lang.quality > 0 {
+			languages = append(languages, lang)
+		}
+	}
+
+	sort.SliceStable(languages, func(i, j int) bool { return languages[i].quality > languages[j].quality })
+
+	var tags []string
+	for _, lang := range languages {
+		tags = append(tags, lang.tag)
+	}
+	return tags
+}
+
+// matchLocale return supported locale matches candidate, candidates from header could match supported locales with same language
+func matchLocale(candidate string, supported []string, matchLanguage bool) string {
+	if candidate == "" || len(supported) == 0 {
+		return candidate
+	}
+
+	for _, locale := range supported {
+		if strings.EqualFold(locale, candidate) {
+			return locale
+		}
+	}
+
+	if matchLanguage {
+		language := strings.ToLower(strings.SplitN(candidate, "-", 2)[0])
+		for _, locale := range supported {
+			if strings.ToLower(strings.SplitN(locale, "-", 2)[0]) == language {
+				return locale
+			}
+		}
+	}
+	return ""
+}
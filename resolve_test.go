@@ -0,0 +1,39 @@
+package i18n
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResolveLocale(t *testing.T) {
+	i18n := New(&backend{})
+
+	req := httptest.NewRequest("GET", "/?locale=de-DE", nil)
+	req.AddCookie(&http.Cookie{Name: "locale", Value: "ja-JP"})
+	req.Header.Set("Accept-Language", "fr;q=0.5, zh;q=0.9, en-GB;q=0.8")
+
+	supported := []string{"en-US", "zh-CN", "de-DE", "ja-JP"}
+	cases := []struct {
+		options  ResolveOptions
+		expected string
+	}{
+		{ResolveOptions{}, "de-DE"},
+		{ResolveOptions{Order: []LocaleSource{FromCookie, FromQuery}}, "ja-JP"},
+		{ResolveOptions{Order: []LocaleSource{FromHeader, FromCookie}, Supported: supported}, "zh-CN"},
+		{ResolveOptions{Order: []LocaleSource{FromHeader}}, "zh"},
+		{ResolveOptions{QueryParam: "lang", CookieName: "lang", Supported: supported}, "zh-CN"},
+		{ResolveOptions{Header: "X-Locale", Order: []LocaleSource{FromHeader}, Default: "zh-CN"}, "zh-CN"},
+		{ResolveOptions{Order: []LocaleSource{FromQuery}, Supported: []string{"en-US"}}, "en-US"},
+	}
+
+	for idx, c := range cases {
+		if result := i18n.ResolveLocale(req, c.options); result != c.expected {
+			t.Errorf("#%v: locale should be %v, but got %v", idx, c.expected, result)
+		}
+	}
+
+	if result := i18n.ResolveLocale(httptest.NewRequest("GET", "/", nil), ResolveOptions{}); result != Default {
+		t.Errorf("should return default locale, but got %v", result)
+	}
+}
@@ -0,0 +1,68 @@
+package i18n
+
+import "strings"
+
+// KeySeparator separator of scopes and key segments, e.g: `home.title`
+// A literal dot inside a scope or key segment should be escaped as `\.` with `EscapeKey`, so `v1\.2.title` (segments `v1.2`, `title`) doesn't collide with `v1.2.title` (segments `v1`, `2`, `title`)
+const KeySeparator = "."
+
+// EscapeKey escape backslashes and dots of a key segment, so they won't be treated as escapes or separators, e.g: `a\` => `a\\`, `v1.2` => `v1\.2`
+func EscapeKey(segment string) string {
+	return strings.NewReplacer(`\`, `\\`, KeySeparator, `\`+KeySeparator).Replace(segment)
+}
+
+// isKeyEscape check if key has an escaped backslash or separator at i
+func isKeyEscape(key string, i int) bool {
+	return key[i] == '\\' && i+1 < len(key) && (key[i+1] == '\\' || key[i+1] == KeySeparator[0])
+}
+
+// Scope return a copy of I18n that looks up keys under scope, e.g: `I18n.Scope("home").T("en-US", "title")` translates `home.title`
+// Scopes could be nested, dots of scope are separators unless they are escaped with `EscapeKey`
+func (i18n *I18n) Scope(scope string) *I18n {
+	clone := i18n.clone()
+	if i18n.scope != "" {
+		clone.scope = joinKey(i18n.scope, scope)
+	} else {
+		clone.scope = scope
+	}
+	return clone
+}
+
+// joinKey join scope and key with KeySeparator, dots of segments should have been escaped with `EscapeKey`
+func joinKey(scope, key string) string {
+	return scope + KeySeparator + key
+}
+
+// SplitKey split key by unescaped separators, escaped backslashes and dots of segments are unescaped, it reverses joining segments escaped with `EscapeKey`
+func SplitKey(key string) []string {
+	var (
+		segments []string
+		segment  []byte
+	)
+
+	for i := 0; i < len(key); i++ {
+		if isKeyEscape(key, i) {
+			segment = append(segment, key[i+1])
+			i++
+		} else if key[i] == KeySeparator[0] {
+			segments = append(segments, string(segment))
+			segment = nil
+		} else {
+			segment = append(segment, key[i])
+		}
+	}
+	return append(segments, string(segment))
+}
+
+// lastSeparatorIndex return index of the last unescaped separator of key, or -1 if there is none
+func lastSeparatorIndex(key string) int {
+	idx := -1
+	for i := 0; i < len(key); i++ {
+		if isKeyEscape(key, i) {
+			i++
+		} else if key[i] == KeySeparator[0] {
+			idx = i
+		}
+	}
+	return idx
+}
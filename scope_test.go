@@ -0,0 +1,66 @@
+package i18n
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScope(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "home.title", Locale: "en-US", Value: "Home"})
+	i18n.AddTranslation(&Translation{Key: "home.banner.title", Locale: "en-US", Value: "Banner"})
+	i18n.AddTranslation(&Translation{Key: `api.v1\.2.title`, Locale: "en-US", Value: "API v1.2"})
+	i18n.AddTranslation(&Translation{Key: "api.v1.2.title", Locale: "en-US", Value: "API v1 section 2"})
+
+	if result := i18n.Scope("home").T("en-US", "title"); result != "Home" {
+		t.Errorf("should translate key under scope, but got %v", result)
+	}
+
+	if result := i18n.Scope("home").Scope("banner").T("en-US", "title"); result != "Banner" {
+		t.Errorf("should translate key under nested scope, but got %v", result)
+	}
+
+	if result := i18n.Scope("api").Scope(EscapeKey("v1.2")).T("en-US", "title"); result != "API v1.2" {
+		t.Errorf("escaped dots should be part of scope segment, but got %v", result)
+	}
+
+	if result := i18n.Scope("api.v1").T("en-US", "2.title"); result != "API v1 section 2" {
+		t.Errorf("unescaped dots should be separators, but got %v", result)
+	}
+
+	i18n.Scope("home").T("en-US", EscapeKey("sign.up"))
+	if _, ok := i18n.Raw("en-US", `home.sign\.up`); !ok {
+		t.Errorf("missing translation should be created with scoped key")
+	}
+
+	if result := i18n.Scope("home").T("en-US", "missing"); result != "missing" {
+		t.Errorf("should return key without scope for missing translation, but got %v", result)
+	}
+}
+
+func TestSplitKey(t *testing.T) {
+	cases := map[string][]string{
+		"home.title":      {"home", "title"},
+		`api.v1\.2.title`: {"api", "v1.2", "title"},
+		`title\.`:         {"title."},
+		"title":           {"title"},
+		`path\\.title`:    {`path\`, "title"},
+		`a\b.title`:       {`a\b`, "title"},
+	}
+
+	for key, segments := range cases {
+		if result := SplitKey(key); !reflect.DeepEqual(result, segments) {
+			t.Errorf("%v should be split into %v, but got %v", key, segments, result)
+		}
+	}
+
+	for _, segment := range []string{`path\`, `v1.2`, `a\.b`} {
+		if result := SplitKey(joinKey(EscapeKey(segment), "title")); !reflect.DeepEqual(result, []string{segment, "title"}) {
+			t.Errorf("escaped segment %v should round-trip, but got %v", segment, result)
+		}
+	}
+
+	if result := humanizeKey(`version.v1\.2_release`); result != "V1.2 release" {
+		t.Errorf("should humanize last segment, but got %v", result)
+	}
+}
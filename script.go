@@ -0,0 +1,50 @@
+package i18n
+
+import (
+	"fmt"
+	"regexp"
+	"unicode"
+)
+
+// scriptCodes ISO 15924 codes of scripts, names of `unicode.Scripts` like `Cyrillic` could be used too
+var scriptCodes = map[string]string{
+	"Arab": "Arabic", "Armn": "Armenian", "Beng": "Bengali", "Cyrl": "Cyrillic", "Deva": "Devanagari",
+	"Geor": "Georgian", "Grek": "Greek", "Hang": "Hangul", "Hans": "Han", "Hant": "Han", "Hebr": "Hebrew",
+	"Kana": "Katakana", "Hira": "Hiragana", "Khmr": "Khmer", "Latn": "Latin", "Taml": "Tamil", "Thai": "Thai",
+}
+
+// scriptIgnoredRegexp template actions and html tags are ignored when validating scripts
+var scriptIgnoredRegexp = regexp.MustCompile(`{{.*?}}|<[^>]*>`)
+
+// ValidateScript return errors for translations of locale contain characters outside expectedScript, e.g: Latin characters in a Cyrillic locale may be untranslated text
+// expectedScript is a ISO 15924 code like `Cyrl` or a name of `unicode.Scripts` like `Cyrillic`, shared characters like punctuation, numbers and spaces are allowed
+func (i18n *I18n) ValidateScript(locale, expectedScript string) []error {
+	name := expectedScript
+	if n, ok := scriptCodes[expectedScript]; ok {
+		name = n
+	}
+
+	script, ok := unicode.Scripts[name]
+	if !ok {
+		return []error{fmt.Errorf("unknown script %v", expectedScript)}
+	}
+
+	var errs []error
+	for _, translation := range i18n.LoadTranslationsSorted() {
+		if translation.Locale != locale {
+			continue
+		}
+
+		var unexpected []rune
+		for _, r := range scriptIgnoredRegexp.ReplaceAllString(translation.Value, "") {
+			if !unicode.In(r, script, unicode.Common, unicode.Inherited) {
+				unexpected = append(unexpected, r)
+			}
+		}
+
+		if len(unexpected) > 0 {
+			errs = append(errs, fmt.Errorf("translation %v of %v has characters outside %v script: %q", translation.Key, locale, expectedScript, string(unexpected)))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,32 @@
+package i18n
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateScript(t *testing.T) {
+	i18n := New(&sliceBackend{translations: []*Translation{
+		{Locale: "ru-RU", Key: "hello", Value: "Привет, {{$1}}!"},
+		{Locale: "ru-RU", Key: "count", Value: "Всего: 1 234 (100%) — <b>ок</b>"},
+		{Locale: "ru-RU", Key: "title", Value: "Welcome домой"},
+		{Locale: "ru-RU", Key: "greek", Value: "Скидка α"},
+		{Locale: "en-US", Key: "title", Value: "Welcome home"},
+	}})
+
+	errs := i18n.ValidateScript("ru-RU", "Cyrl")
+	if len(errs) != 2 {
+		t.Fatalf("mixed-script values should be flagged, got %v", errs)
+	}
+	if !strings.Contains(errs[0].Error(), "greek") || !strings.Contains(errs[1].Error(), "title") || !strings.Contains(errs[1].Error(), "Welcome") {
+		t.Errorf("unexpected errors %v", errs)
+	}
+
+	if errs := i18n.ValidateScript("en-US", "Latin"); len(errs) != 0 {
+		t.Errorf("script name should be supported, got %v", errs)
+	}
+
+	if errs := i18n.ValidateScript("ru-RU", "Klingon"); len(errs) != 1 || !strings.Contains(errs[0].Error(), "unknown script") {
+		t.Errorf("should return error for unknown script, got %v", errs)
+	}
+}
@@ -0,0 +1,160 @@
+package i18n
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+var numberSpellers = struct {
+	sync.RWMutex
+	spellers map[string]func(n int64) string
+}{spellers: map[string]func(n int64) string{"en": spellEnglish, "de": spellGerman}}
+
+// RegisterNumberSpeller register speller used by SpellNumber for language, e.g: `RegisterNumberSpeller("fr", spellFrench)`
+func RegisterNumberSpeller(lang string, speller func(n int64) string) {
+	numberSpellers.Lock()
+	numberSpellers.spellers[lang] = speller
+	numberSpellers.Unlock()
+}
+
+// SpellNumber spell out number n in words of locale like CLDR spellout rules, e.g: `SpellNumber("en-US", 21)` => `twenty-one`
+// English and German are supported by default, digits will be returned if locale is unsupported
+func SpellNumber(locale string, n int64) string {
+	base, _ := language.Make(locale).Base()
+
+	numberSpellers.RLock()
+	speller, ok := numberSpellers.spellers[base.String()]
+	numberSpellers.RUnlock()
+
+	if ok {
+		return speller(n)
+	}
+	return strconv.FormatInt(n, 10)
+}
+
+// numberScales scales of large numbers, from the largest
+var numberScales = []uint64{1e18, 1e15, 1e12, 1e9, 1e6, 1e3}
+
+// absNumber return absolute value of n without overflow
+func absNumber(n int64) uint64 {
+	if n < 0 {
+		return uint64(-(n + 1)) + 1
+	}
+	return uint64(n)
+}
+
+var (
+	englishUnits = []string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+		"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+	englishTens   = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	englishScales = []string{"quintillion", "quadrillion", "trillion", "billion", "million", "thousand"}
+)
+
+func spellEnglish(n int64) string {
+	value := absNumber(n)
+	if value == 0 {
+		return englishUnits[0]
+	}
+
+	var words []string
+	if n < 0 {
+		words = append(words, "minus")
+	}
+	for idx, scale := range numberScales {
+		if value >= scale {
+			words = append(words, spellEnglishHundreds(value/scale), englishScales[idx])
+			value %= scale
+		}
+	}
+	if value > 0 {
+		words = append(words, spellEnglishHundreds(value))
+	}
+	return strings.Join(words, " ")
+}
+
+// spellEnglishHundreds spell number between 1 and 999 in English
+func spellEnglishHundreds(n uint64) string {
+	var words []string
+	if n >= 100 {
+		words = append(words, englishUnits[n/100], "hundred")
+		n %= 100
+	}
+	if n >= 20 {
+		word := englishTens[n/10]
+		if n%10 > 0 {
+			word += "-" + englishUnits[n%10]
+		}
+		words = append(words, word)
+	} else if n > 0 {
+		words = append(words, englishUnits[n])
+	}
+	return strings.Join(words, " ")
+}
+
+var (
+	germanUnits = []string{"null", "eins", "zwei", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun", "zehn",
+		"elf", "zwölf", "dreizehn", "vierzehn", "fünfzehn", "sechzehn", "siebzehn", "achtzehn", "neunzehn"}
+	germanTens   = []string{"", "", "zwanzig", "dreißig", "vierzig", "fünfzig", "sechzig", "siebzig", "achtzig", "neunzig"}
+	germanScales = [][2]string{{"Trillion", "Trillionen"}, {"Billiarde", "Billiarden"}, {"Billion", "Billionen"}, {"Milliarde", "Milliarden"}, {"Million", "Millionen"}}
+)
+
+func spellGerman(n int64) string {
+	value := absNumber(n)
+	if value == 0 {
+		return germanUnits[0]
+	}
+
+	var words []string
+	if n < 0 {
+		words = append(words, "minus")
+	}
+	for idx, scale := range numberScales[:len(germanScales)] {
+		if count := value / scale; count == 1 {
+			words = append(words, "eine", germanScales[idx][0])
+		} else if count > 1 {
+			words = append(words, spellGermanHundreds(count, false), germanScales[idx][1])
+		}
+		value %= scale
+	}
+
+	var word string
+	if value >= 1000 {
+		word = spellGermanHundreds(value/1000, true) + "tausend"
+		value %= 1000
+	}
+	if value > 0 {
+		word += spellGermanHundreds(value, false)
+	}
+	if word != "" {
+		words = append(words, word)
+	}
+	return strings.Join(words, " ")
+}
+
+// spellGermanHundreds spell number between 1 and 999 in German, a trailing one is spelled as `ein` if compound
+func spellGermanHundreds(n uint64, compound bool) string {
+	var word string
+	if n >= 100 {
+		word = spellGermanUnit(n/100, true) + "hundert"
+		n %= 100
+	}
+	if n >= 20 {
+		if n%10 > 0 {
+			word += spellGermanUnit(n%10, true) + "und"
+		}
+		word += germanTens[n/10]
+	} else if n > 0 {
+		word += spellGermanUnit(n, compound)
+	}
+	return word
+}
+
+func spellGermanUnit(n uint64, compound bool) string {
+	if n == 1 && compound {
+		return "ein"
+	}
+	return germanUnits[n]
+}
@@ -0,0 +1,45 @@
+package i18n
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSpellNumber(t *testing.T) {
+	cases := []struct {
+		locale string
+		number int64
+		result string
+	}{
+		{"en-US", 0, "zero"},
+		{"en-US", 7, "seven"},
+		{"en-US", 21, "twenty-one"},
+		{"en", 123, "one hundred twenty-three"},
+		{"en-GB", -1005, "minus one thousand five"},
+		{"en-US", 2000040, "two million forty"},
+		{"en-US", math.MinInt64, "minus nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eight"},
+		{"de-DE", 0, "null"},
+		{"de-DE", 1, "eins"},
+		{"de", 21, "einundzwanzig"},
+		{"de-AT", 101, "einhunderteins"},
+		{"de-DE", 1234, "eintausendzweihundertvierunddreißig"},
+		{"de-DE", 2000000, "zwei Millionen"},
+		{"de-DE", 1000001, "eine Million eins"},
+		{"de-DE", -30, "minus dreißig"},
+		{"ja-JP", 42, "42"},
+		{"", -3, "-3"},
+	}
+
+	for _, c := range cases {
+		if result := SpellNumber(c.locale, c.number); result != c.result {
+			t.Errorf("spell %v in %v: expect %q, but got %q", c.number, c.locale, c.result, result)
+		}
+	}
+}
+
+func TestRegisterNumberSpeller(t *testing.T) {
+	RegisterNumberSpeller("nl", func(n int64) string { return "nummer" })
+	if result := SpellNumber("nl-NL", 3); result != "nummer" {
+		t.Errorf("should use registered speller, but got %v", result)
+	}
+}
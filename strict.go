@@ -0,0 +1,119 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// strictLoadArgs dummy arguments used to parse translations referencing positional arguments when loading in strict mode
+var strictLoadArgs = []interface{}{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+
+// strictLoadArgsFor return dummy arguments to parse value in strict mode, values without positional arguments get a map with a dummy number for each field they reference, e.g: `{{.Name}}`
+func strictLoadArgsFor(value string) []interface{} {
+	if positionalArgRegexp.MatchString(value) {
+		return strictLoadArgs
+	}
+
+	fields := map[string]interface{}{}
+	for _, actions := range templateActionRegexp.FindAllStringSubmatch(value, -1) {
+		for _, matches := range fieldArgRegexp.FindAllStringSubmatch(actions[1], -1) {
+			fields[matches[1]] = 1
+		}
+	}
+	for _, matches := range pluralArgRegexp.FindAllStringSubmatch(value, -1) {
+		if name := matches[2] + matches[3]; name != "" {
+			fields[name] = 1
+		}
+	}
+	return []interface{}{fields}
+}
+
+// NewStrict initialize I18n with backends in StrictLoad mode, it returns error if any translation has invalid syntax
+func NewStrict(backends ...Backend) (*I18n, error) {
+	i18n := New()
+	i18n.Backends = backends
+	i18n.StrictLoad = true
+	return i18n, i18n.Reload()
+}
+
+// Reload load translations from backends into cache store again
+// In StrictLoad mode, all translations are parsed with dummy arguments first, nothing will be loaded if any of them has invalid syntax
+func (i18n *I18n) Reload() error {
+	if i18n.StrictLoad {
+		if err := i18n.validateSyntax(i18n.LoadTranslationsSorted()); err != nil {
+			return err
+		}
+	}
+
+	i18n.loadToCacheStore()
+	return nil
+}
+
+// ReloadBackend load translations of backend into cache store again, translations of backends have higher priority are kept
+// Translations removed from the backend stay in the cache store until `Reload`, and syntax is validated first in StrictLoad mode
+func (i18n *I18n) ReloadBackend(backend Backend) error {
+	idx := -1
+	for i, b := range i18n.Backends {
+		if b == backend {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return fmt.Errorf("backend %T isn't registered", backend)
+	}
+
+	var results []*Translation
+	if errorReportingBackend, ok := backend.(ErrorReportingBackend); ok {
+		var err error
+		if results, err = errorReportingBackend.LoadTranslationsE(); err != nil {
+			return err
+		}
+	} else {
+		results = backend.LoadTranslations()
+	}
+
+	if i18n.StrictLoad {
+		if err := i18n.validateSyntax(results); err != nil {
+			return err
+		}
+	}
+
+	var overridden = map[string]bool{}
+	for _, b := range i18n.Backends[:idx] {
+		for _, translation := range i18n.loadBackendTranslations(b) {
+			overridden[i18n.cacheKeyFor(translation.Locale, translation.Key)] = true
+		}
+	}
+
+	for _, translation := range results {
+		if i18n.isCachedLocale(translation.Locale) && !overridden[i18n.cacheKeyFor(translation.Locale, translation.Key)] {
+			i18n.AddTranslation(translation)
+		}
+	}
+	return nil
+}
+
+// validateSyntax parse translations with CLDR provider, errors of all invalid translations will be combined together
+func (i18n *I18n) validateSyntax(translations []*Translation) error {
+	if i18n.formatter != nil && i18n.formatter != CLDRFormatter {
+		return nil
+	}
+
+	var messages []string
+	for _, translation := range translations {
+		if !strings.Contains(translation.Value, "{{") {
+			continue
+		}
+
+		if _, err := i18n.getCLDRProvider().Parse(translation.Locale, translation.Value, strictLoadArgsFor(translation.Value)...); err != nil {
+			messages = append(messages, fmt.Sprintf("translation %v of %v is invalid: %v", translation.Key, translation.Locale, err))
+		}
+	}
+
+	if len(messages) > 0 {
+		return errors.New(strings.Join(messages, "; "))
+	}
+	return nil
+}
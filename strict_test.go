@@ -0,0 +1,101 @@
+package i18n
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStrictLoad(t *testing.T) {
+	translations := &sliceBackend{translations: []*Translation{
+		{Key: "hello", Locale: "en-US", Value: "Hello {{$1}}"},
+		{Key: "count", Locale: "en-US", Value: `{{p "Count" (one "{{.Count}} item") (other "{{.Count}} items")}}`},
+		{Key: "welcome", Locale: "en-US", Value: "Welcome {{.Name}}"},
+	}}
+
+	i18n, err := NewStrict(translations)
+	if err != nil {
+		t.Fatalf("valid translations should be loaded, but got %v", err)
+	}
+
+	if result := i18n.T("en-US", "hello", "Jinzhu"); result != "Hello Jinzhu" {
+		t.Errorf("translations should be loaded, but got %v", result)
+	}
+
+	if result := i18n.T("en-US", "welcome", map[string]interface{}{"Name": "Jinzhu"}); result != "Welcome Jinzhu" {
+		t.Errorf("translations with named arguments should be loaded, but got %v", result)
+	}
+
+	if args := strictLoadArgsFor("Welcome {{.Name}}"); !reflect.DeepEqual(args, []interface{}{map[string]interface{}{"Name": 1}}) {
+		t.Errorf("named placeholders should be parsed with fields of dummy data, but got %v", args)
+	}
+
+	translations.translations = append(translations.translations, &Translation{Key: "broken", Locale: "zh-CN", Value: "你好 {{$1"})
+	if _, err := NewStrict(translations); err == nil || !strings.Contains(err.Error(), "translation broken of zh-CN is invalid") {
+		t.Errorf("should return error for invalid translation, but got %v", err)
+	}
+
+	if err := i18n.Reload(); err == nil {
+		t.Errorf("reload should return error for invalid translation")
+	}
+
+	if _, ok := i18n.Raw("zh-CN", "broken"); ok {
+		t.Errorf("translations shouldn't be loaded if any of them is invalid")
+	}
+
+	i18n.StrictLoad = false
+	if err := i18n.Reload(); err != nil {
+		t.Errorf("invalid translations should be loaded if not in strict mode, but got %v", err)
+	}
+}
+
+func TestReloadBackend(t *testing.T) {
+	first := &countingBackend{sliceBackend: sliceBackend{translations: []*Translation{{Locale: "en-US", Key: "title", Value: "First Title"}}}}
+	second := &countingBackend{sliceBackend: sliceBackend{translations: []*Translation{
+		{Locale: "en-US", Key: "title", Value: "Second Title"},
+		{Locale: "en-US", Key: "hello", Value: "Hello"},
+	}}}
+	third := &countingBackend{sliceBackend: sliceBackend{translations: []*Translation{{Locale: "en-US", Key: "bye", Value: "Bye"}}}}
+	i18n := New(first, second, third)
+
+	second.translations = []*Translation{
+		{Locale: "en-US", Key: "title", Value: "New Second Title"},
+		{Locale: "en-US", Key: "hello", Value: "New Hello"},
+	}
+	first.translations[0].Value = "New First Title"
+	third.translations[0].Value = "New Bye"
+
+	loads := third.loads
+	if err := i18n.ReloadBackend(second); err != nil {
+		t.Fatalf("failed to reload backend, got %v", err)
+	}
+	if third.loads != loads {
+		t.Errorf("backends have lower priority shouldn't be loaded")
+	}
+
+	for key, value := range map[string]string{"hello": "New Hello", "title": "First Title", "bye": "Bye"} {
+		if result := i18n.T("en-US", key); string(result) != value {
+			t.Errorf("%v should be %v after reloading backend, but got %v", key, value, result)
+		}
+	}
+
+	if err := i18n.ReloadBackend(&backend{}); err == nil {
+		t.Errorf("should return error for backend not registered")
+	}
+}
+
+func TestReloadBackendStrict(t *testing.T) {
+	backend := &sliceBackend{translations: []*Translation{{Locale: "en-US", Key: "hello", Value: "Hello"}}}
+	i18n, err := NewStrict(backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backend.translations = []*Translation{{Locale: "en-US", Key: "hello", Value: "Hello {{ .Name "}}
+	if err := i18n.ReloadBackend(backend); err == nil {
+		t.Errorf("should return error for invalid syntax in strict mode")
+	}
+	if result := i18n.T("en-US", "hello"); result != "Hello" {
+		t.Errorf("translations shouldn't be updated if reloading failed, got %v", result)
+	}
+}
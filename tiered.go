@@ -0,0 +1,109 @@
+package i18n
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/qor/cache"
+	"github.com/qor/i18n/internal/cacheutil"
+)
+
+// DefaultTieredCacheTTL how long values are kept in l1 of tiered cache store set with `SetTieredCache` before they are read from l2 again
+var DefaultTieredCacheTTL = time.Minute
+
+// SetTieredCache set cache store with two tiers, e.g: in-process memory store as l1 and shared Redis store as l2
+// Reads check l1 then l2, values found in l2 are copied to l1 and expire after `DefaultTieredCacheTTL`. Writes and deletes go to both tiers
+// Translations evicted from tiers like `lru.New(max)` will be reloaded from backends
+func (i18n *I18n) SetTieredCache(l1, l2 cache.CacheStoreInterface) {
+	i18n.SetTieredCacheWithTTL(l1, l2, DefaultTieredCacheTTL)
+}
+
+// SetTieredCacheWithTTL set cache store with two tiers like `SetTieredCache`, values in l1 expire after ttl, so changes made by other instances to l2 are visible after that
+// Values in l1 never expire if ttl is zero
+func (i18n *I18n) SetTieredCacheWithTTL(l1, l2 cache.CacheStoreInterface, ttl time.Duration) {
+	i18n.SetCacheStore(&tieredCacheStore{l1: l1, l2: l2, ttl: ttl})
+}
+
+type tieredCacheStore struct {
+	l1  cache.CacheStoreInterface
+	l2  cache.CacheStoreInterface
+	ttl time.Duration
+}
+
+// tieredCacheEntry value saved in l1 with its expiration
+type tieredCacheEntry struct {
+	Value     string
+	ExpiresAt time.Time
+}
+
+func (store *tieredCacheStore) Get(key string) (string, error) {
+	var entry tieredCacheEntry
+	if err := store.l1.Unmarshal(key, &entry); err == nil && (entry.ExpiresAt.IsZero() || time.Now().Before(entry.ExpiresAt)) {
+		return entry.Value, nil
+	}
+
+	value, err := store.l2.Get(key)
+	if err == nil {
+		store.setL1(key, value)
+	} else {
+		store.l1.Delete(key)
+	}
+	return value, err
+}
+
+func (store *tieredCacheStore) Unmarshal(key string, object interface{}) error {
+	value, err := store.Get(key)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal([]byte(value), object)
+}
+
+func (store *tieredCacheStore) Set(key string, value interface{}) error {
+	str, err := cacheutil.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	if err := store.l2.Set(key, value); err != nil {
+		return err
+	}
+	return store.setL1(key, str)
+}
+
+func (store *tieredCacheStore) setL1(key, value string) error {
+	entry := tieredCacheEntry{Value: value}
+	if store.ttl > 0 {
+		entry.ExpiresAt = time.Now().Add(store.ttl)
+	}
+	return store.l1.Set(key, entry)
+}
+
+func (store *tieredCacheStore) Fetch(key string, fc func() interface{}) (string, error) {
+	if value, err := store.Get(key); err == nil {
+		return value, nil
+	}
+
+	if err := store.Set(key, fc()); err != nil {
+		return "", err
+	}
+	return store.Get(key)
+}
+
+func (store *tieredCacheStore) Delete(key string) error {
+	err := store.l2.Delete(key)
+	if e := store.l1.Delete(key); err == nil {
+		err = e
+	}
+	return err
+}
+
+// HasEvicted return true if any tier has evicted entries
+func (store *tieredCacheStore) HasEvicted() bool {
+	for _, tier := range []cache.CacheStoreInterface{store.l1, store.l2} {
+		if evicting, ok := tier.(EvictingCacheStore); ok && evicting.HasEvicted() {
+			return true
+		}
+	}
+	return false
+}
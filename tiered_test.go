@@ -0,0 +1,82 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/qor/cache/memory"
+)
+
+func TestTieredCache(t *testing.T) {
+	l1, l2 := memory.New(), memory.New()
+	i18n := New(&sliceBackend{translations: []*Translation{{Locale: "en-US", Key: "hello", Value: "Hello"}}})
+	i18n.SetTieredCache(l1, l2)
+
+	for name, store := range map[string]*memory.Memory{"l1": l1, "l2": l2} {
+		if _, err := store.Get(cacheKey("en-US", "hello")); err != nil {
+			t.Errorf("translations loaded from backends should be written to %v, got %v", name, err)
+		}
+	}
+
+	i18n.AddTranslation(&Translation{Locale: "en-US", Key: "bye", Value: "Bye"})
+	if _, err := l2.Get(cacheKey("en-US", "bye")); err != nil {
+		t.Errorf("translation should be written through to l2, got %v", err)
+	}
+
+	// another instance shares l2 with its own l1
+	otherL1 := memory.New()
+	other := New()
+	other.SetTieredCache(otherL1, l2)
+	i18n.AddTranslation(&Translation{Locale: "en-US", Key: "title", Value: "Title"})
+
+	if _, err := otherL1.Get(cacheKey("en-US", "title")); err == nil {
+		t.Errorf("l1 of other instance shouldn't have the translation before reading")
+	}
+	if value := other.T("en-US", "title"); value != "Title" {
+		t.Errorf("should read through l2, got %v", value)
+	}
+	if _, err := otherL1.Get(cacheKey("en-US", "title")); err != nil {
+		t.Errorf("value read from l2 should be copied to l1, got %v", err)
+	}
+
+	l2.Delete(cacheKey("en-US", "title"))
+	if value := other.T("en-US", "title"); value != "Title" {
+		t.Errorf("should read from l1 first, got %v", value)
+	}
+
+	i18n.DeleteTranslation(&Translation{Locale: "en-US", Key: "hello"})
+	for name, store := range map[string]*memory.Memory{"l1": l1, "l2": l2} {
+		if _, err := store.Get(cacheKey("en-US", "hello")); err == nil {
+			t.Errorf("deleted translation should be removed from %v", name)
+		}
+	}
+}
+
+func TestTieredCacheTTL(t *testing.T) {
+	l2 := memory.New()
+	i18n := New()
+	i18n.SetTieredCacheWithTTL(memory.New(), l2, 20*time.Millisecond)
+	other := New()
+	other.SetTieredCacheWithTTL(memory.New(), l2, 20*time.Millisecond)
+
+	i18n.AddTranslation(&Translation{Locale: "en-US", Key: "hello", Value: "Hello"})
+	if value := other.T("en-US", "hello"); value != "Hello" {
+		t.Errorf("should read through shared l2, got %v", value)
+	}
+
+	i18n.AddTranslation(&Translation{Locale: "en-US", Key: "hello", Value: "Hi"})
+	if value := other.T("en-US", "hello"); value != "Hello" {
+		t.Errorf("should read from l1 before it expires, got %v", value)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	if value := other.T("en-US", "hello"); value != "Hi" {
+		t.Errorf("should read changes of other instance from l2 after l1 expired, got %v", value)
+	}
+
+	i18n.DeleteTranslation(&Translation{Locale: "en-US", Key: "hello"})
+	time.Sleep(30 * time.Millisecond)
+	if _, ok := other.Raw("en-US", "hello"); ok {
+		t.Errorf("translation deleted by other instance should be gone after l1 expired")
+	}
+}
@@ -0,0 +1,114 @@
+package i18n
+
+import (
+	"html/template"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TTruncate translate with locale, key and arguments, and truncate the result to max grapheme clusters with ellipsis appended
+// Grapheme clusters are user-perceived characters, so emoji sequences and combining marks won't be split
+// HTML tags like `<span class="i18n-missing">` added by `MarkUntranslated` aren't counted, tags still open at the cut are closed after ellipsis, and entities count as one character
+func (i18n *I18n) TTruncate(locale, key string, max int, ellipsis string, args ...interface{}) template.HTML {
+	result := string(i18n.T(locale, key, args...))
+
+	var (
+		count, end int
+		openTags   []string
+	)
+	for end < len(result) {
+		if result[end] == '<' {
+			if length := strings.IndexByte(result[end:], '>'); length > 0 {
+				tag := result[end : end+length+1]
+				if name := htmlTagName(tag); strings.HasPrefix(tag, "</") {
+					if len(openTags) > 0 && openTags[len(openTags)-1] == name {
+						openTags = openTags[:len(openTags)-1]
+					}
+					end += len(tag)
+					continue
+				} else if count < max {
+					if name != "" && !strings.HasSuffix(tag, "/>") && !htmlVoidElements[name] {
+						openTags = append(openTags, name)
+					}
+					end += len(tag)
+					continue
+				}
+			}
+		}
+
+		if count == max {
+			closing := ""
+			for idx := len(openTags) - 1; idx >= 0; idx-- {
+				closing += "</" + openTags[idx] + ">"
+			}
+			return template.HTML(result[:end] + ellipsis + closing)
+		}
+
+		if result[end] == '&' {
+			if length := strings.IndexByte(result[end:], ';'); length > 1 && length <= 10 && !strings.ContainsAny(result[end+1:end+length], " <&") {
+				end += length + 1
+				count++
+				continue
+			}
+		}
+		end += nextGraphemeLength(result[end:])
+		count++
+	}
+	return template.HTML(result)
+}
+
+var htmlVoidElements = map[string]bool{"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true, "img": true, "input": true, "link": true, "meta": true, "source": true, "track": true, "wbr": true}
+
+// htmlTagName return lower case name of tag like `<span class="x">` or `</span>`, it returns blank for comments and doctypes
+func htmlTagName(tag string) string {
+	name := strings.TrimPrefix(strings.TrimPrefix(tag, "<"), "/")
+	if idx := strings.IndexAny(name, " \t\n/>"); idx >= 0 {
+		name = name[:idx]
+	}
+	if strings.HasPrefix(name, "!") || strings.HasPrefix(name, "?") {
+		return ""
+	}
+	return strings.ToLower(name)
+}
+
+// nextGraphemeLength return bytes length of the first grapheme cluster of str
+// It handles CRLF, combining marks, variation selectors, emoji modifiers, zero width joiner sequences and regional indicator pairs
+func nextGraphemeLength(str string) int {
+	r, size := utf8.DecodeRuneInString(str)
+	if r == '\r' && len(str) > size && str[size] == '\n' {
+		return size + 1
+	}
+
+	if isRegionalIndicator(r) {
+		if next, nextSize := utf8.DecodeRuneInString(str[size:]); isRegionalIndicator(next) {
+			size += nextSize
+		}
+	}
+
+	for size < len(str) {
+		next, nextSize := utf8.DecodeRuneInString(str[size:])
+		switch {
+		case isGraphemeExtend(next):
+			size += nextSize
+		case next == '\u200d':
+			size += nextSize
+			if size < len(str) {
+				_, joinedSize := utf8.DecodeRuneInString(str[size:])
+				size += joinedSize
+			}
+		default:
+			return size
+		}
+	}
+	return size
+}
+
+func isGraphemeExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) ||
+		(r >= 0xFE00 && r <= 0xFE0F) || (r >= 0x1F3FB && r <= 0x1F3FF) || (r >= 0xE0020 && r <= 0xE007F)
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
@@ -0,0 +1,60 @@
+package i18n
+
+import "testing"
+
+func TestTTruncate(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "short", Locale: "en-US", Value: "Hi"})
+	i18n.AddTranslation(&Translation{Key: "cafe", Locale: "en-US", Value: "café café"})
+	i18n.AddTranslation(&Translation{Key: "family", Locale: "en-US", Value: "\U0001F468\u200d\U0001F469\u200d\U0001F467\U0001F44D\U0001F3FD\U0001F1E8\U0001F1F3 family"})
+	i18n.AddTranslation(&Translation{Key: "hello", Locale: "zh-CN", Value: "你好，{{$1}}"})
+
+	cases := []struct {
+		locale, key string
+		max         int
+		expected    string
+	}{
+		{"en-US", "short", 5, "Hi"},
+		{"en-US", "short", 2, "Hi"},
+		{"en-US", "cafe", 4, "café..."},
+		{"en-US", "family", 3, "\U0001F468\u200d\U0001F469\u200d\U0001F467\U0001F44D\U0001F3FD\U0001F1E8\U0001F1F3..."},
+		{"en-US", "family", 1, "\U0001F468\u200d\U0001F469\u200d\U0001F467..."},
+		{"zh-CN", "hello", 4, "你好，世..."},
+		{"en-US", "short", 0, "..."},
+	}
+
+	for _, c := range cases {
+		if result := i18n.TTruncate(c.locale, c.key, c.max, "...", "世界"); string(result) != c.expected {
+			t.Errorf("%v truncated to %v should be %q, but got %q", c.key, c.max, c.expected, result)
+		}
+	}
+}
+
+func TestTTruncateMarkup(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "bold", Locale: "en-US", Value: "<b>Hello</b> <i>world</i><br/>!"})
+	i18n.AddTranslation(&Translation{Key: "entity", Locale: "en-US", Value: "Tom &amp; Jerry"})
+
+	cases := []struct {
+		key      string
+		max      int
+		expected string
+	}{
+		{"bold", 3, "<b>Hel...</b>"},
+		{"bold", 5, "<b>Hello</b>..."},
+		{"bold", 8, "<b>Hello</b> <i>wo...</i>"},
+		{"bold", 12, "<b>Hello</b> <i>world</i><br/>!"},
+		{"entity", 5, "Tom &amp;..."},
+	}
+
+	for _, c := range cases {
+		if result := i18n.TTruncate("en-US", c.key, c.max, "..."); string(result) != c.expected {
+			t.Errorf("%v truncated to %v should be %q, but got %q", c.key, c.max, c.expected, result)
+		}
+	}
+
+	i18n.MarkUntranslated = true
+	if result := i18n.TTruncate("en-US", "welcome_message", 7, "..."); result != `<span class="i18n-missing">welcome...</span>` {
+		t.Errorf("marked untranslated value should be truncated inside the span, but got %q", result)
+	}
+}
@@ -0,0 +1,62 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DeleteUnused delete translations of all locales from backends and cache store if their keys aren't in liveKeys, return count of deleted translations
+// It returns error without deleting anything if liveKeys is blank, use `ForceDeleteUnused` to delete all translations
+func (i18n *I18n) DeleteUnused(liveKeys []string) (int, error) {
+	if len(liveKeys) == 0 {
+		return 0, errors.New("no live keys given, use ForceDeleteUnused to delete all translations")
+	}
+	return i18n.ForceDeleteUnused(liveKeys)
+}
+
+// ForceDeleteUnused delete translations whose keys aren't in liveKeys like `DeleteUnused`, all translations will be deleted if liveKeys is blank
+// Only translations deleted from all writable backends are counted and removed from cache store, the first failure is returned after trying all translations
+func (i18n *I18n) ForceDeleteUnused(liveKeys []string) (int, error) {
+	live := map[string]bool{}
+	for _, key := range liveKeys {
+		live[key] = true
+	}
+
+	var (
+		count    int
+		firstErr error
+	)
+	for _, translation := range i18n.LoadTranslationsSorted() {
+		if live[translation.Key] {
+			continue
+		}
+
+		if err := i18n.deleteFromBackends(translation); err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("failed to delete translation %v of %v, got: %v", translation.Key, translation.Locale, err)
+			}
+			continue
+		}
+
+		if err := i18n.deleteCached(translation.Locale, translation.Key); err != nil && firstErr == nil {
+			firstErr = err
+		}
+		count++
+	}
+	return count, firstErr
+}
+
+// deleteFromBackends delete translation from all backends except read-only ones, it returns the first error but still tries other backends
+func (i18n *I18n) deleteFromBackends(translation *Translation) error {
+	var firstErr error
+	for _, backend := range i18n.Backends {
+		if readOnly, ok := backend.(ReadOnlyBackend); ok && readOnly.ReadOnly() {
+			continue
+		}
+
+		if err := i18n.retry(func() error { return backend.DeleteTranslation(translation) }); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
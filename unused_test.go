@@ -0,0 +1,142 @@
+package i18n
+
+import (
+	"errors"
+	"testing"
+)
+
+type deletableBackend struct {
+	sliceBackend
+}
+
+func (b *deletableBackend) DeleteTranslation(t *Translation) error {
+	for idx, translation := range b.translations {
+		if translation.Locale == t.Locale && translation.Key == t.Key {
+			b.translations = append(b.translations[:idx], b.translations[idx+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func TestDeleteUnused(t *testing.T) {
+	first := &deletableBackend{sliceBackend{translations: []*Translation{
+		{Locale: "en-US", Key: "live", Value: "Live"},
+		{Locale: "en-US", Key: "stale", Value: "Stale"},
+	}}}
+	second := &deletableBackend{sliceBackend{translations: []*Translation{
+		{Locale: "zh-CN", Key: "live", Value: "活的"},
+		{Locale: "zh-CN", Key: "stale", Value: "旧的"},
+		{Locale: "zh-CN", Key: "old", Value: "老的"},
+	}}}
+	i18n := New(first, second)
+
+	if _, err := i18n.DeleteUnused(nil); err == nil {
+		t.Errorf("should return error when no live keys given")
+	}
+	if len(first.translations) != 2 || len(second.translations) != 3 {
+		t.Errorf("should not delete anything when no live keys given")
+	}
+
+	count, err := i18n.DeleteUnused([]string{"live"})
+	if err != nil {
+		t.Fatalf("failed to delete unused translations, got %v", err)
+	}
+	if count != 3 {
+		t.Errorf("should delete 3 unused translations, but got %v", count)
+	}
+	if len(first.translations) != 1 || len(second.translations) != 1 {
+		t.Errorf("should only keep live translations in backends, got %v, %v", first.translations, second.translations)
+	}
+
+	if value := string(i18n.T("zh-CN", "stale")); value != "stale" {
+		t.Errorf("deleted translation should be removed from cache, got %v", value)
+	}
+	if value := string(i18n.T("zh-CN", "live")); value != "活的" {
+		t.Errorf("live translation should be kept, got %v", value)
+	}
+}
+
+func TestForceDeleteUnused(t *testing.T) {
+	backend := &deletableBackend{sliceBackend{translations: []*Translation{
+		{Locale: "en-US", Key: "hello", Value: "Hello"},
+		{Locale: "en-US", Key: "bye", Value: "Bye"},
+	}}}
+	i18n := New(backend)
+
+	count, err := i18n.ForceDeleteUnused(nil)
+	if err != nil || count != 2 {
+		t.Errorf("should delete all translations, got %v, %v", count, err)
+	}
+	if len(backend.translations) != 0 {
+		t.Errorf("backend should be empty, got %v", backend.translations)
+	}
+}
+
+type undeletableBackend struct {
+	deletableBackend
+	locked string
+}
+
+func (b *undeletableBackend) DeleteTranslation(t *Translation) error {
+	if t.Key == b.locked {
+		return errors.New("translation is locked")
+	}
+	return b.deletableBackend.DeleteTranslation(t)
+}
+
+func TestForceDeleteUnusedWithFailures(t *testing.T) {
+	backend := &undeletableBackend{deletableBackend: deletableBackend{sliceBackend{translations: []*Translation{
+		{Locale: "en-US", Key: "hello", Value: "Hello"},
+		{Locale: "en-US", Key: "bye", Value: "Bye"},
+	}}}, locked: "bye"}
+	i18n := New(backend)
+
+	count, err := i18n.ForceDeleteUnused(nil)
+	if err == nil {
+		t.Errorf("should return error if failed to delete translation from backend")
+	}
+	if count != 1 {
+		t.Errorf("only deleted translations should be counted, got %v", count)
+	}
+	if value := i18n.T("en-US", "bye"); value != "Bye" {
+		t.Errorf("translation failed to delete should be kept in cache, got %v", value)
+	}
+}
+
+type readOnlyUndeletableBackend struct {
+	sliceBackend
+}
+
+func (readOnlyUndeletableBackend) ReadOnly() bool {
+	return true
+}
+
+func (readOnlyUndeletableBackend) DeleteTranslation(t *Translation) error {
+	return errors.New("backend is read-only")
+}
+
+func TestForceDeleteUnusedWithReadOnlyBackends(t *testing.T) {
+	embedded := &readOnlyUndeletableBackend{sliceBackend{translations: []*Translation{
+		{Locale: "en-US", Key: "hello", Value: "Hello"},
+	}}}
+	backend := &deletableBackend{sliceBackend{translations: []*Translation{
+		{Locale: "en-US", Key: "hello", Value: "Hello"},
+		{Locale: "en-US", Key: "bye", Value: "Bye"},
+	}}}
+	i18n := New(embedded, backend)
+
+	count, err := i18n.ForceDeleteUnused(nil)
+	if err != nil {
+		t.Errorf("read-only backends should be skipped, got %v", err)
+	}
+	if count != 2 {
+		t.Errorf("translations deleted from all writable backends should be counted, got %v", count)
+	}
+	if len(backend.translations) != 0 || len(embedded.translations) != 1 {
+		t.Errorf("only writable backends should be changed, got %v, %v", backend.translations, embedded.translations)
+	}
+	if value := i18n.T("en-US", "bye"); value != "bye" {
+		t.Errorf("deleted translation should be removed from cache, got %v", value)
+	}
+}
@@ -0,0 +1,19 @@
+package i18n
+
+import (
+	"fmt"
+
+	"golang.org/x/text/language"
+)
+
+// ValidateLocale return error if locale isn't a well-formed BCP 47 language tag
+func ValidateLocale(locale string) error {
+	if locale == "" {
+		return fmt.Errorf("invalid locale %q: locale is blank", locale)
+	}
+
+	if _, err := language.Parse(locale); err != nil {
+		return fmt.Errorf("invalid locale %q: %v", locale, err)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package i18n
+
+import "testing"
+
+func TestValidateLocales(t *testing.T) {
+	backend := &sliceBackend{}
+	i18n := New(backend)
+	i18n.ValidateLocales = true
+
+	for _, locale := range []string{"", "en_US_", "en--US", "english-United-States", "-en"} {
+		if err := i18n.SaveTranslation(&Translation{Locale: locale, Key: "hello", Value: "Hello"}); err == nil {
+			t.Errorf("should reject malformed locale %q", locale)
+		}
+	}
+
+	for _, locale := range []string{"en", "en-US", "zh-Hans-CN", "es-419"} {
+		if err := i18n.SaveTranslation(&Translation{Locale: locale, Key: "hello", Value: "Hello"}); err != nil {
+			t.Errorf("should accept locale %q, but got %v", locale, err)
+		}
+	}
+
+	if len(backend.translations) != 4 {
+		t.Errorf("only translations of valid locales should be saved, got %v", len(backend.translations))
+	}
+
+	i18n.ValidateLocales = false
+	if err := i18n.SaveTranslation(&Translation{Locale: "en_US_", Key: "hello", Value: "Hello"}); err != nil {
+		t.Errorf("locales shouldn't be validated if ValidateLocales is disabled, got %v", err)
+	}
+}
+
+func TestValidateLocalesForBatchSave(t *testing.T) {
+	backend := &transactionalBackend{mapBackend: mapBackend{translations: map[string]*Translation{}}}
+	i18n := New(backend)
+	i18n.ValidateLocales = true
+
+	if err := i18n.SetAll("brand", "QOR", "en-US", "zh_CN_"); err == nil {
+		t.Errorf("should reject malformed locale in batch")
+	}
+	if len(backend.translations) != 0 || backend.transactions != 0 {
+		t.Errorf("no translation should be saved if any locale is malformed, got %v", backend.translations)
+	}
+
+	i18n.T("zh_CN_", "missing")
+	if _, ok := i18n.Raw("zh_CN_", "missing"); ok {
+		t.Errorf("translation of malformed locale shouldn't be auto created")
+	}
+}
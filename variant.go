@@ -0,0 +1,36 @@
+package i18n
+
+import "html/template"
+
+// DefaultVariantSeparator default separator of key and variant for `TVariant`
+const DefaultVariantSeparator = "#"
+
+// SetVariantSeparator set separator of key and variant for `TVariant`
+func (i18n *I18n) SetVariantSeparator(separator string) {
+	i18n.variantSeparator = separator
+}
+
+// TVariant translate `key#variant` if it exists for locale or its fallback locales, otherwise translate key, it could be used for A/B testing copy
+func (i18n *I18n) TVariant(locale, key, variant string, args ...interface{}) template.HTML {
+	if variant != "" {
+		separator := i18n.variantSeparator
+		if separator == "" {
+			separator = DefaultVariantSeparator
+		}
+
+		variantKey := key + separator + variant
+		translationKey := i18n.resolveKeyAlias(variantKey)
+		if i18n.scope != "" {
+			translationKey = joinKey(i18n.scope, translationKey)
+		}
+
+		if locale == "" {
+			locale = i18n.getDefaultLocale()
+		}
+
+		if _, ok := i18n.findTranslation(locale, translationKey); ok {
+			return i18n.T(locale, variantKey, args...)
+		}
+	}
+	return i18n.T(locale, key, args...)
+}
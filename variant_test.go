@@ -0,0 +1,40 @@
+package i18n
+
+import "testing"
+
+func TestTVariant(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "signup.button", Locale: "en-US", Value: "Sign up"})
+	i18n.AddTranslation(&Translation{Key: "signup.button#b", Locale: "en-US", Value: "Join {{$1}} now"})
+	i18n.AddTranslation(&Translation{Key: "signup.button", Locale: "zh-CN", Value: "注册"})
+	i18n.AddTranslation(&Translation{Key: "home.title", Locale: "en-US", Value: "Home"})
+	i18n.AddTranslation(&Translation{Key: "home.title:b", Locale: "en-US", Value: "Homepage"})
+
+	cases := []struct {
+		locale, key, variant, expected string
+	}{
+		{"en-US", "signup.button", "b", "Join QOR now"},
+		{"en-US", "signup.button", "c", "Sign up"},
+		{"en-US", "signup.button", "", "Sign up"},
+		{"zh-CN", "signup.button", "b", "Join QOR now"},
+	}
+
+	for _, c := range cases {
+		if result := i18n.TVariant(c.locale, c.key, c.variant, "QOR"); string(result) != c.expected {
+			t.Errorf("variant %v of %v should be %v, but got %v", c.variant, c.key, c.expected, result)
+		}
+	}
+
+	if _, ok := i18n.Raw("en-US", "signup.button#c"); ok {
+		t.Errorf("missing variant shouldn't be created")
+	}
+
+	if result := i18n.Scope("signup").TVariant("en-US", "button", "b", "QOR"); result != "Join QOR now" {
+		t.Errorf("should translate variant under scope, but got %v", result)
+	}
+
+	i18n.SetVariantSeparator(":")
+	if result := i18n.TVariant("en-US", "home.title", "b"); result != "Homepage" {
+		t.Errorf("should use configured separator, but got %v", result)
+	}
+}
@@ -0,0 +1,43 @@
+package i18n
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"io"
+	"sort"
+)
+
+// Version return a hash of cached translations, it changes once any translation changes and is stable for same translations
+// Only translations of given locales are counted if any locale passed, it could be used as ETag for clients to cache translations
+// Blank translations are ignored, so auto-created missing translations won't change the version
+func (i18n *I18n) Version(locales ...string) string {
+	if len(locales) == 0 {
+		return versionOf(i18n.cachedTranslations())
+	}
+
+	var (
+		translations []*Translation
+		counted      = map[string]bool{}
+	)
+	locales = append([]string{}, locales...)
+	sort.Strings(locales)
+	for _, locale := range locales {
+		if !counted[locale] {
+			counted[locale] = true
+			i18n.loadLocaleLazily(locale)
+			translations = append(translations, i18n.cachedTranslationsOf(locale)...)
+		}
+	}
+	return versionOf(translations)
+}
+
+// versionOf return a hash of translations with value, translations should be sorted
+func versionOf(translations []*Translation) string {
+	hash := sha1.New()
+	for _, translation := range translations {
+		if translation.Value != "" {
+			io.WriteString(hash, translation.Locale+"\x00"+translation.Key+"\x00"+translation.Value+"\n")
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
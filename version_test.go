@@ -0,0 +1,61 @@
+package i18n
+
+import "testing"
+
+type mapBackend struct {
+	translations map[string]*Translation
+}
+
+func (b *mapBackend) LoadTranslations() (translations []*Translation) {
+	for _, translation := range b.translations {
+		translations = append(translations, translation)
+	}
+	return translations
+}
+
+func (b *mapBackend) SaveTranslation(t *Translation) error {
+	b.translations[cacheKey(t.Locale, t.Key)] = &Translation{Key: t.Key, Locale: t.Locale, Value: t.Value, Auto: t.Auto}
+	return nil
+}
+
+func (b *mapBackend) DeleteTranslation(t *Translation) error {
+	delete(b.translations, cacheKey(t.Locale, t.Key))
+	return nil
+}
+
+func TestVersion(t *testing.T) {
+	i18n := New(&mapBackend{translations: map[string]*Translation{
+		"en-US/hello": {Key: "hello", Locale: "en-US", Value: "Hello"},
+		"zh-CN/hello": {Key: "hello", Locale: "zh-CN", Value: "你好"},
+	}})
+
+	version, zhVersion := i18n.Version(), i18n.Version("zh-CN")
+	if version == "" || version != i18n.Version() {
+		t.Errorf("version should be stable for same translations")
+	}
+
+	i18n.T("en-US", "hello")
+	i18n.T("en-US", "missing")
+	if i18n.Version() != version {
+		t.Errorf("reading translations shouldn't change version")
+	}
+
+	i18n.SaveTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hi"})
+	if i18n.Version() == version {
+		t.Errorf("version should be changed after translation changed")
+	}
+
+	if i18n.Version("zh-CN") != zhVersion {
+		t.Errorf("version of other locales shouldn't be changed")
+	}
+
+	i18n.SaveTranslation(&Translation{Key: "hello", Locale: "en-US", Value: "Hello"})
+	if i18n.Version() != version {
+		t.Errorf("version should be same for same translations")
+	}
+
+	i18n.AddTranslation(&Translation{Key: "bye", Locale: "en-US", Value: "Bye"})
+	if i18n.Version() == version {
+		t.Errorf("version should be changed after translation added to cache store")
+	}
+}
@@ -0,0 +1,68 @@
+package i18n
+
+import "sync"
+
+// warmWorkers max count of goroutines used by Warm to load translations from backends implementing `TranslationLoader`
+const warmWorkers = 8
+
+// Warm load translations of keys for locales from backends into cache store, it reduces latency of first requests after cache cleared
+// Translations are loaded concurrently with bounded workers if all backends implement `TranslationLoader`, otherwise backends are loaded once
+// Keys missing in backends and locales excluded by `LoadTranslationsFor` are skipped, it is safe to call Warm while translating
+func (i18n *I18n) Warm(locales, keys []string) {
+	var cachedLocales []string
+	for _, locale := range locales {
+		if i18n.isCachedLocale(locale) {
+			cachedLocales = append(cachedLocales, locale)
+		}
+	}
+
+	if !i18n.loadsTranslationsByKey() {
+		translations := i18n.LoadTranslations()
+		for _, locale := range cachedLocales {
+			for _, key := range keys {
+				if translation, ok := translations[locale][key]; ok {
+					i18n.AddTranslation(translation)
+				}
+			}
+		}
+		return
+	}
+
+	var (
+		wg    sync.WaitGroup
+		queue = make(chan [2]string)
+	)
+
+	for i := 0; i < warmWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for pair := range queue {
+				for _, backend := range i18n.Backends {
+					if translation := i18n.loadBackendTranslation(backend, pair[0], pair[1]); translation != nil {
+						i18n.AddTranslation(translation)
+						break
+					}
+				}
+			}
+		}()
+	}
+
+	for _, locale := range cachedLocales {
+		for _, key := range keys {
+			queue <- [2]string{locale, key}
+		}
+	}
+	close(queue)
+	wg.Wait()
+}
+
+// loadsTranslationsByKey check if all backends could load single translation with `TranslationLoader`
+func (i18n *I18n) loadsTranslationsByKey() bool {
+	for _, backend := range i18n.Backends {
+		if _, ok := backend.(TranslationLoader); !ok {
+			return false
+		}
+	}
+	return len(i18n.Backends) > 0
+}
@@ -0,0 +1,81 @@
+package i18n
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/qor/cache/memory"
+)
+
+func TestWarm(t *testing.T) {
+	var (
+		translations []*Translation
+		locales      = []string{"en-US", "zh-CN", "de-DE"}
+		keys         []string
+	)
+
+	for i := 0; i < 20; i++ {
+		keys = append(keys, fmt.Sprintf("key%v", i))
+		for _, locale := range locales {
+			translations = append(translations, &Translation{Key: fmt.Sprintf("key%v", i), Locale: locale, Value: locale + fmt.Sprint(i)})
+		}
+	}
+
+	i18n := New(&sliceBackend{translations: translations})
+	i18n.cacheStore = memory.New()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for _, key := range keys {
+			i18n.T("zh-CN", key)
+		}
+	}()
+	i18n.Warm(locales, append(keys, "missing"))
+	wg.Wait()
+
+	for _, locale := range locales {
+		for i, key := range keys {
+			if value, ok := i18n.Raw(locale, key); !ok || value != locale+fmt.Sprint(i) {
+				t.Errorf("%v of %v should be cached, but got %v", key, locale, value)
+			}
+		}
+	}
+
+	if _, ok := i18n.Raw("en-US", "missing"); ok {
+		t.Errorf("missing keys shouldn't be cached")
+	}
+}
+
+func TestWarmWithTranslationLoader(t *testing.T) {
+	backend := &loaderBackend{}
+	for i := 0; i < 20; i++ {
+		for _, locale := range []string{"en-US", "zh-CN"} {
+			backend.translations = append(backend.translations, &Translation{Key: fmt.Sprintf("key%v", i), Locale: locale, Value: locale + fmt.Sprint(i)})
+		}
+	}
+
+	i18n := New(backend)
+	i18n.LoadTranslationsFor("en-US")
+	i18n.cacheStore = memory.New()
+
+	var keys []string
+	for i := 0; i < 20; i++ {
+		keys = append(keys, fmt.Sprintf("key%v", i))
+	}
+
+	loads := backend.loads
+	i18n.Warm([]string{"en-US", "zh-CN"}, keys)
+
+	if backend.loads != loads || backend.lookups != len(keys) {
+		t.Errorf("translations should be loaded by key only for cached locales, got %v loads and %v lookups", backend.loads-loads, backend.lookups)
+	}
+	if value, ok := i18n.Raw("en-US", "key3"); !ok || value != "en-US3" {
+		t.Errorf("translation should be warmed, got %v", value)
+	}
+	if _, err := i18n.cacheStore.Get(i18n.cacheKeyFor("zh-CN", "key3")); err == nil {
+		t.Errorf("locales excluded by LoadTranslationsFor shouldn't be warmed")
+	}
+}
@@ -0,0 +1,98 @@
+package i18n
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+type xliffDocument struct {
+	XMLName xml.Name    `xml:"urn:oasis:names:tc:xliff:document:1.2 xliff"`
+	Version string      `xml:"version,attr"`
+	Files   []xliffFile `xml:"file"`
+}
+
+type xliffFile struct {
+	SourceLanguage string           `xml:"source-language,attr"`
+	TargetLanguage string           `xml:"target-language,attr,omitempty"`
+	Datatype       string           `xml:"datatype,attr"`
+	Original       string           `xml:"original,attr"`
+	Units          []xliffTransUnit `xml:"body>trans-unit"`
+}
+
+type xliffTransUnit struct {
+	ID     string      `xml:"id,attr"`
+	Source string      `xml:"source"`
+	Target xliffTarget `xml:"target"`
+}
+
+type xliffTarget struct {
+	State string `xml:"state,attr,omitempty"`
+	Value string `xml:",chardata"`
+}
+
+// XLIFF states used when exporting, units imported with these states will be skipped
+const (
+	xliffStateTranslated       = "translated"
+	xliffStateNeedsTranslation = "needs-translation"
+	xliffStateNew              = "new"
+)
+
+// ExportXLIFF export translations as XLIFF 1.2 document, keys of source locale will be exported as `trans-unit`s
+// Units without target value are marked with state `needs-translation`
+func (i18n *I18n) ExportXLIFF(sourceLocale, targetLocale string, w io.Writer) error {
+	var (
+		translations = i18n.LoadTranslations()
+		file         = xliffFile{SourceLanguage: sourceLocale, TargetLanguage: targetLocale, Datatype: "plaintext", Original: "i18n"}
+		keys         []string
+	)
+
+	for key := range translations[sourceLocale] {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		unit := xliffTransUnit{ID: key, Source: translations[sourceLocale][key].Value, Target: xliffTarget{State: xliffStateNeedsTranslation}}
+		if target, ok := translations[targetLocale][key]; ok && target.Value != "" {
+			unit.Target = xliffTarget{State: xliffStateTranslated, Value: target.Value}
+		}
+		file.Units = append(file.Units, unit)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	return encoder.Encode(xliffDocument{Version: "1.2", Files: []xliffFile{file}})
+}
+
+// ImportXLIFF import targets of XLIFF 1.2 document as translations of the file's target language
+// Units that have no target or are marked with state `new` or `needs-translation` will be skipped
+// Existing translations are kept by default, pass `ImportOptions` to choose another strategy
+func (i18n *I18n) ImportXLIFF(r io.Reader, opts ...ImportOptions) error {
+	var document xliffDocument
+	if err := xml.NewDecoder(r).Decode(&document); err != nil {
+		return fmt.Errorf("failed to parse XLIFF, got: %v", err)
+	}
+
+	var translations []*Translation
+	for _, file := range document.Files {
+		if file.TargetLanguage == "" {
+			return errors.New("target-language is required to import XLIFF")
+		}
+
+		for _, unit := range file.Units {
+			if unit.Target.Value == "" || unit.Target.State == xliffStateNew || unit.Target.State == xliffStateNeedsTranslation {
+				continue
+			}
+
+			translations = append(translations, &Translation{Key: unit.ID, Locale: file.TargetLanguage, Value: unit.Target.Value})
+		}
+	}
+	return i18n.importTranslations(translations, importOptionsFrom(opts))
+}
@@ -0,0 +1,68 @@
+package i18n
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestExportAndImportXLIFF(t *testing.T) {
+	i18n := New(&mapBackend{translations: map[string]*Translation{
+		"en-US/hello": {Key: "hello", Locale: "en-US", Value: "Hello & welcome"},
+		"en-US/bye":   {Key: "bye", Locale: "en-US", Value: "Bye"},
+		"zh-CN/hello": {Key: "hello", Locale: "zh-CN", Value: "你好"},
+	}})
+
+	var buf bytes.Buffer
+	if err := i18n.ExportXLIFF("en-US", "zh-CN", &buf); err != nil {
+		t.Fatalf("failed to export XLIFF, got %v", err)
+	}
+
+	for _, str := range []string{`source-language="en-US"`, `target-language="zh-CN"`, `<trans-unit id="hello">`, "<source>Hello &amp; welcome</source>", `<target state="translated">你好</target>`, `<target state="needs-translation"></target>`} {
+		if !strings.Contains(buf.String(), str) {
+			t.Errorf("exported XLIFF should contain %v, but got %v", str, buf.String())
+		}
+	}
+
+	other := New(&mapBackend{translations: map[string]*Translation{}})
+	if err := other.ImportXLIFF(&buf); err != nil {
+		t.Fatalf("failed to import XLIFF, got %v", err)
+	}
+
+	if result := other.T("zh-CN", "hello"); result != "你好" {
+		t.Errorf("translated unit should be imported, but got %v", result)
+	}
+
+	if _, found := other.findTranslation("zh-CN", "bye"); found {
+		t.Errorf("units need translation shouldn't be imported")
+	}
+}
+
+func TestImportXLIFF(t *testing.T) {
+	i18n := New(&mapBackend{translations: map[string]*Translation{}})
+	document := `<?xml version="1.0" encoding="UTF-8"?>
+<xliff version="1.2" xmlns="urn:oasis:names:tc:xliff:document:1.2">
+  <file source-language="en-US" target-language="de-DE" datatype="plaintext" original="app">
+    <body>
+      <trans-unit id="hello"><source>Hello</source><target>Hallo</target></trans-unit>
+      <trans-unit id="bye"><source>Bye</source><target state="new">Tschüss</target></trans-unit>
+    </body>
+  </file>
+</xliff>`
+
+	if err := i18n.ImportXLIFF(strings.NewReader(document)); err != nil {
+		t.Fatalf("failed to import XLIFF, got %v", err)
+	}
+
+	if result := i18n.T("de-DE", "hello"); result != "Hallo" {
+		t.Errorf("unit should be imported, but got %v", result)
+	}
+
+	if _, found := i18n.findTranslation("de-DE", "bye"); found {
+		t.Errorf("units with state new shouldn't be imported")
+	}
+
+	if err := i18n.ImportXLIFF(strings.NewReader("<xliff")); err == nil {
+		t.Errorf("should return error for invalid XLIFF")
+	}
+}
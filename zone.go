@@ -0,0 +1,40 @@
+package i18n
+
+import (
+	"html/template"
+	"time"
+)
+
+// TInZone translate with locale, key and arguments, time arguments (including values of named arguments) are converted to loc before rendering
+func (i18n *I18n) TInZone(locale string, loc *time.Location, key string, args ...interface{}) template.HTML {
+	if loc == nil {
+		return i18n.T(locale, key, args...)
+	}
+
+	zonedArgs := make([]interface{}, len(args))
+	for idx, arg := range args {
+		if values, ok := arg.(map[string]interface{}); ok {
+			zonedValues := map[string]interface{}{}
+			for name, value := range values {
+				zonedValues[name] = inZone(value, loc)
+			}
+			zonedArgs[idx] = zonedValues
+		} else {
+			zonedArgs[idx] = inZone(arg, loc)
+		}
+	}
+	return i18n.T(locale, key, zonedArgs...)
+}
+
+func inZone(value interface{}, loc *time.Location) interface{} {
+	switch v := value.(type) {
+	case time.Time:
+		return v.In(loc)
+	case *time.Time:
+		if v != nil {
+			t := v.In(loc)
+			return &t
+		}
+	}
+	return value
+}
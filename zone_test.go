@@ -0,0 +1,32 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTInZone(t *testing.T) {
+	i18n := New(&backend{})
+	i18n.AddTranslation(&Translation{Key: "shipped_at", Locale: "en-US", Value: "Shipped at {{$1}}"})
+	i18n.AddTranslation(&Translation{Key: "delivered_at", Locale: "en-US", Value: "Delivered at {{.Time}} by {{.Name}}"})
+
+	timestamp := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	tokyo := time.FixedZone("JST", 9*3600)
+	newYork := time.FixedZone("EST", -5*3600)
+
+	if result := i18n.TInZone("en-US", tokyo, "shipped_at", timestamp); string(result) != "Shipped at "+timestamp.In(tokyo).String() {
+		t.Errorf("should render time in Tokyo, but got %v", result)
+	}
+
+	if result := i18n.TInZone("en-US", newYork, "shipped_at", &timestamp); string(result) != "Shipped at "+timestamp.In(newYork).String() {
+		t.Errorf("should render time in New York, but got %v", result)
+	}
+
+	if result := i18n.TInZone("en-US", tokyo, "delivered_at", map[string]interface{}{"Time": timestamp, "Name": "Jinzhu"}); string(result) != "Delivered at "+timestamp.In(tokyo).String()+" by Jinzhu" {
+		t.Errorf("should render time of named arguments in Tokyo, but got %v", result)
+	}
+
+	if timestamp.Location() != time.UTC {
+		t.Errorf("arguments shouldn't be changed")
+	}
+}